@@ -0,0 +1,110 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/sha3"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// SolanaAddress returns the Solana address for an Ed25519 public key.
+func SolanaAddress(pubKey []byte) string {
+	return base58Encode(pubKey)
+}
+
+// StellarAddress returns the Stellar account ID for an Ed25519 public key.
+func StellarAddress(pubKey []byte) string {
+	// Version byte for an account ID is 6 << 3.
+	data := append([]byte{6 << 3}, pubKey...)
+	checksum := make([]byte, 2)
+	binary.LittleEndian.PutUint16(checksum, crc16XModem(data))
+
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(append(data, checksum...))
+}
+
+// AlgorandAddress returns the Algorand address for an Ed25519 public key.
+func AlgorandAddress(pubKey []byte) string {
+	hash := sha512.Sum512_256(pubKey)
+	data := append(append([]byte{}, pubKey...), hash[len(hash)-4:]...)
+
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(data)
+}
+
+// NEARAddress returns the NEAR implicit account ID for an Ed25519 public key.
+func NEARAddress(pubKey []byte) string {
+	return hex.EncodeToString(pubKey)
+}
+
+// AptosAddress returns the Aptos address for an Ed25519 public key.
+func AptosAddress(pubKey []byte) string {
+	// Single-key Ed25519 scheme identifier is 0x00.
+	hash := sha3.Sum256(append(append([]byte{}, pubKey...), 0x00))
+
+	return "0x" + hex.EncodeToString(hash[:])
+}
+
+// SuiAddress returns the Sui address for an Ed25519 public key.
+func SuiAddress(pubKey []byte) string {
+	// Ed25519 signature scheme flag is 0x00.
+	hash := blake2b.Sum256(append([]byte{0x00}, pubKey...))
+
+	return "0x" + hex.EncodeToString(hash[:])
+}
+
+func base58Encode(data []byte) string {
+	value := new(big.Int).SetBytes(data)
+	base := big.NewInt(58)
+	mod := new(big.Int)
+	res := make([]byte, 0, len(data)*138/100+1)
+	for value.Sign() > 0 {
+		value.DivMod(value, base, mod)
+		res = append(res, base58Alphabet[mod.Int64()])
+	}
+	// Leading zero bytes are encoded as the first character of the alphabet.
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		res = append(res, base58Alphabet[0])
+	}
+	for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+
+	return string(res)
+}
+
+func crc16XModem(data []byte) uint16 {
+	crc := uint16(0)
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
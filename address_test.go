@@ -0,0 +1,47 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddresses(t *testing.T) {
+	zero := make([]byte, 32)
+
+	require.Equal(t, "11111111111111111111111111111111", SolanaAddress(zero))
+	require.Equal(t, "GAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAWHF", StellarAddress(zero))
+	require.Equal(t, "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ", AlgorandAddress(zero))
+	require.Equal(t, "0000000000000000000000000000000000000000000000000000000000000000", NEARAddress(zero))
+	require.Equal(t, "0xdc33296e4d20f0ef35ff9fd449e23ebbaa5a049a17779db3c2fe194b499aaf74", AptosAddress(zero))
+	require.Equal(t, "0xd8908c165dee785924e7421a0fd0418a19d5daeec395fd505a92a0fd3117e428", SuiAddress(zero))
+}
+
+func TestBase58Encode(t *testing.T) {
+	require.Equal(t, "", base58Encode([]byte{}))
+	require.Equal(t, "1112", base58Encode([]byte{0x00, 0x00, 0x00, 0x01}))
+	require.Equal(t, "StV1DL6CwTryKyV", base58Encode([]byte("hello world")))
+}
+
+func TestStellarAddressSEP0005(t *testing.T) {
+	seed, err := SeedFromMnemonic("bench hurt jump file august wise shallow faculty impulse spring exact slush thunder author capable act festival slice deposit sauce coconut afford frown better", "")
+	require.NoError(t, err)
+	key, err := DeriveKey(seed, "m/44'/148'/0'")
+	require.NoError(t, err)
+	pubKey, err := key.PublicKey()
+	require.NoError(t, err)
+	require.Equal(t, "GC3MMSXBWHL6CPOAVERSJITX7BH76YU252WGLUOM5CJX3E7UCYZBTPJQ", StellarAddress(pubKey))
+}
@@ -38,7 +38,12 @@ func Keys(seed []byte, path string) ([]byte, []byte, error) {
 }
 
 // DeriveKey derives a key given a seed and a derivation path.
+// The coin type in the path can be given by name, for example m/44'/solana'/0'.
 func DeriveKey(seed []byte, path string) (*Key, error) {
+	path, err := resolveCoinType(path)
+	if err != nil {
+		return nil, err
+	}
 	if !isValidPath(path) {
 		return nil, ErrInvalidPath
 	}
@@ -53,6 +58,12 @@ func DeriveKey(seed []byte, path string) (*Key, error) {
 		return nil, err
 	}
 
+	return deriveKeyElements(key, elements)
+}
+
+// deriveKeyElements derives a key from a parent key and the elements of a relative path.
+func deriveKeyElements(key *Key, elements []uint32) (*Key, error) {
+	var err error
 	for _, element := range elements {
 		// We operate on hardened elements.
 		hardenedElement := element + hardenedOffset
@@ -36,7 +36,7 @@ func TestKeys(t *testing.T) {
 		{
 			name: "NoSeed",
 			path: "m/44'/1901'/0'",
-			err:  "seed must be 64 bytes (passed 0)",
+			err:  "seed must be between 16 and 64 bytes (passed 0)",
 		},
 		{
 			name:    "Good",
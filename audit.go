@@ -0,0 +1,246 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// AuditSeverity is the severity of an audit finding.
+type AuditSeverity int
+
+const (
+	// AuditWarning is a finding that suggests a mnemonic may be weak.
+	AuditWarning AuditSeverity = iota + 1
+	// AuditCritical is a finding that shows a mnemonic must not be used.
+	AuditCritical
+)
+
+// String returns a string representation of the severity.
+func (s AuditSeverity) String() string {
+	switch s {
+	case AuditWarning:
+		return "warning"
+	case AuditCritical:
+		return "critical"
+	default:
+		return "unknown"
+	}
+}
+
+// AuditFinding is a single issue found when auditing a mnemonic.
+type AuditFinding struct {
+	// Code is a stable identifier for the type of finding.
+	Code string
+	// Severity is the severity of the finding.
+	Severity AuditSeverity
+	// Description is a human-readable description of the finding.
+	Description string
+}
+
+// MnemonicAudit is the result of auditing a mnemonic.
+type MnemonicAudit struct {
+	Findings []*AuditFinding
+}
+
+// Passed returns true if the audit found no issues of at least the given severity.
+func (a *MnemonicAudit) Passed(severity AuditSeverity) bool {
+	for _, finding := range a.Findings {
+		if finding.Severity >= severity {
+			return false
+		}
+	}
+
+	return true
+}
+
+const (
+	// maxWordRepeats is the number of times a word may appear before it is flagged.
+	maxWordRepeats = 3
+	// maxIndexRun is the length of a run of consecutive word indices that is flagged.
+	maxIndexRun = 4
+	// minDistinctBytes is the number of distinct entropy bytes below which entropy is flagged.
+	minDistinctBytes = 16
+)
+
+// knownMnemonics are mnemonics that have been published, for example as test
+// vectors, and so must be considered compromised.
+var knownMnemonics = map[string]string{
+	"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon " +
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art": "BIP-39 test vector",
+	"legal winner thank year wave sausage worth useful legal winner thank year " +
+		"wave sausage worth useful legal winner thank year wave sausage worth title": "BIP-39 test vector",
+	"letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd " +
+		"amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic bless": "BIP-39 test vector",
+	"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo " +
+		"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote": "BIP-39 test vector",
+	"hamster diagram private dutch cause delay private meat slide toddler razor book " +
+		"happy fancy gospel tennis maple dilemma loan word shrug inflict delay length": "BIP-39 test vector",
+	"panda eyebrow bullet gorilla call smoke muffin taste mesh discover soft ostrich " +
+		"alcohol speed nation flash devote level hobby quick inner drive ghost inside": "BIP-39 test vector",
+	"all hour make first leader extend hole alien behind guard gospel lava " +
+		"path output census museum junior mass reopen famous sing advance salt reform": "BIP-39 test vector",
+	"void come effort suffer camp survey warrior heavy shoot primary clutch crush " +
+		"open amazing screen patrol group space point ten exist slush involve unfold": "BIP-39 test vector",
+	"knife blouse guide fabric fiction dry shiver trap wrong learn paddle thunder " +
+		"hood version rebel bike expect magic parent foil cushion excess scout barely": "go-ed25519hd test vector",
+	"awesome tide fiction sibling panther movie stable market cause coffee hair clarify " +
+		"celery lady transfer extend save parent decide hollow effort spin notice matter": "go-ed25519hd test vector",
+	"silent toe meat possible chair blossom wait occur this worth option bag " +
+		"nurse find fish scene bench asthma bike wage world quit primary indoor": "SeedXOR example",
+	"romance wink lottery autumn shop bring dawn tongue range crater truth ability " +
+		"miss spice fitness easy legal release recall obey exchange recycle dragon room": "SeedXOR example",
+	"lion misery divide hurry latin fluid camp advance illegal lab pyramid unaware " +
+		"eager fringe sick camera series noodle toy crowd jeans select depth lounge": "SeedXOR example",
+	"vault nominee cradle silk own frown throw leg cactus recall talent worry " +
+		"gadget surface shy planet purpose coffee drip few seven term squeeze educate": "SeedXOR example",
+	"attack pizza motion avocado network gather crop fresh patrol unusual wild holiday " +
+		"candy pony ranch winter theme error hybrid van cereal salon goddess expire": "SeedQR example",
+	"bench hurt jump file august wise shallow faculty impulse spring exact slush " +
+		"thunder author capable act festival slice deposit sauce coconut afford frown better": "SEP-0005 test vector",
+	"cable spray genius state float twenty onion head street palace net private " +
+		"method loan turn phrase state blanket interest dry amazing dress blast tube": "SEP-0005 test vector",
+	"edge defense waste choose enrich upon flee junk siren film clown finish " +
+		"luggage leader kid quick brick print evidence swap drill paddle truly occur": "Nano BIP-39 test vector",
+}
+
+// AuditMnemonic checks a mnemonic for signs that it is weak or compromised.
+// An error is returned if the mnemonic is invalid; otherwise the returned
+// audit contains any issues found.
+func AuditMnemonic(mnemonic string) (*MnemonicAudit, error) {
+	mnemonic = norm.NFKD.String(mnemonic)
+	entropy, err := entropyFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	words := strings.Split(mnemonic, " ")
+
+	audit := &MnemonicAudit{
+		Findings: make([]*AuditFinding, 0),
+	}
+
+	if source, exists := knownMnemonics[mnemonic]; exists {
+		audit.Findings = append(audit.Findings, &AuditFinding{
+			Code:        "known_mnemonic",
+			Severity:    AuditCritical,
+			Description: fmt.Sprintf("mnemonic is a published %s", source),
+		})
+	}
+
+	audit.Findings = append(audit.Findings, auditWords(words)...)
+	audit.Findings = append(audit.Findings, auditEntropy(entropy)...)
+
+	return audit, nil
+}
+
+// auditWords checks the words of a mnemonic for low-entropy patterns.
+func auditWords(words []string) []*AuditFinding {
+	findings := make([]*AuditFinding, 0)
+
+	counts := make(map[string]int)
+	for _, word := range words {
+		counts[word]++
+	}
+	for _, word := range words {
+		if counts[word] > maxWordRepeats {
+			findings = append(findings, &AuditFinding{
+				Code:        "repeated_word",
+				Severity:    AuditWarning,
+				Description: fmt.Sprintf("word %s appears %d times", word, counts[word]),
+			})
+			// Only report each word once.
+			counts[word] = 0
+		}
+	}
+
+	run := 1
+	for i := 1; i < len(words); i++ {
+		if reverseWordMap[words[i]] == reverseWordMap[words[i-1]]+1 {
+			run++
+		} else {
+			run = 1
+		}
+		if run == maxIndexRun {
+			findings = append(findings, &AuditFinding{
+				Code:        "consecutive_words",
+				Severity:    AuditWarning,
+				Description: fmt.Sprintf("words %d to %d are consecutive in the wordlist", i+2-maxIndexRun, i+1),
+			})
+		}
+	}
+
+	prefix := words[0][:1]
+	samePrefix := true
+	for _, word := range words[1:] {
+		if !strings.HasPrefix(word, prefix) {
+			samePrefix = false
+			break
+		}
+	}
+	if samePrefix {
+		findings = append(findings, &AuditFinding{
+			Code:        "same_prefix",
+			Severity:    AuditWarning,
+			Description: fmt.Sprintf("all words start with %q", prefix),
+		})
+	}
+
+	return findings
+}
+
+// auditEntropy checks the entropy of a mnemonic for signs of a weak random
+// number generator.
+func auditEntropy(entropy []byte) []*AuditFinding {
+	findings := make([]*AuditFinding, 0)
+
+	distinct := make(map[byte]bool)
+	for _, b := range entropy {
+		distinct[b] = true
+	}
+	if len(distinct) < minDistinctBytes && len(distinct) < len(entropy)/2 {
+		findings = append(findings, &AuditFinding{
+			Code:        "low_byte_diversity",
+			Severity:    AuditCritical,
+			Description: fmt.Sprintf("entropy contains only %d distinct byte values", len(distinct)),
+		})
+	}
+
+	if zeros := len(entropy) - len(bytes.TrimLeft(entropy, "\x00")); zeros >= 4 {
+		findings = append(findings, &AuditFinding{
+			Code:        "leading_zeros",
+			Severity:    AuditCritical,
+			Description: fmt.Sprintf("entropy starts with %d zero bytes", zeros),
+		})
+	}
+
+	for _, period := range []int{1, 2, 4, 8, 16} {
+		if period >= len(entropy) {
+			break
+		}
+		if bytes.Equal(entropy[period:], entropy[:len(entropy)-period]) {
+			findings = append(findings, &AuditFinding{
+				Code:        "repeating_entropy",
+				Severity:    AuditCritical,
+				Description: fmt.Sprintf("entropy repeats every %d bytes", period),
+			})
+			break
+		}
+	}
+
+	return findings
+}
@@ -0,0 +1,96 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditMnemonic(t *testing.T) {
+	type test struct {
+		name     string
+		mnemonic string
+		err      string
+		codes    []string
+		passed   bool
+	}
+
+	tests := []test{
+		{
+			name:     "Invalid",
+			mnemonic: "abandon abandon",
+			err:      "mnemonic must be 24 words (found 2)",
+		},
+		{
+			name:     "AbandonArt",
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+			codes:    []string{"known_mnemonic", "repeated_word", "same_prefix", "low_byte_diversity", "leading_zeros", "repeating_entropy"},
+		},
+		{
+			name:     "KnownMnemonic",
+			mnemonic: "knife blouse guide fabric fiction dry shiver trap wrong learn paddle thunder hood version rebel bike expect magic parent foil cushion excess scout barely",
+			codes:    []string{"known_mnemonic"},
+		},
+		{
+			name:     "RepeatingEntropy",
+			mnemonic: "legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth title",
+			codes:    []string{"known_mnemonic", "low_byte_diversity", "repeating_entropy"},
+		},
+		{
+			name:     "ConsecutiveWords",
+			mnemonic: "abandon ability able about hamster diagram private dutch cause delay private meat slide toddler razor book happy fancy gospel tennis maple dilemma loan best",
+			codes:    []string{"consecutive_words"},
+		},
+		{
+			name:     "Good",
+			mnemonic: "tornado cactus wheel picture target finish home neither trend picture shoulder endless deputy glide open oxygen another ability forum swear side alcohol devote random",
+			codes:    []string{},
+			passed:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			audit, err := AuditMnemonic(test.mnemonic)
+			if test.err == "" {
+				require.NoError(t, err)
+				codes := make([]string, len(audit.Findings))
+				for i, finding := range audit.Findings {
+					codes[i] = finding.Code
+				}
+				require.Equal(t, test.codes, codes)
+				require.Equal(t, test.passed, audit.Passed(AuditWarning))
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestAuditPassed(t *testing.T) {
+	audit := &MnemonicAudit{
+		Findings: []*AuditFinding{
+			{
+				Code:     "repeated_word",
+				Severity: AuditWarning,
+			},
+		},
+	}
+	require.False(t, audit.Passed(AuditWarning))
+	require.True(t, audit.Passed(AuditCritical))
+	require.Equal(t, "warning", AuditWarning.String())
+	require.Equal(t, "critical", AuditCritical.String())
+}
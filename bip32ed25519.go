@@ -0,0 +1,304 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+
+	"filippo.io/edwards25519"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidMasterKey is returned when a seed does not generate a valid BIP32-Ed25519 master key.
+	ErrInvalidMasterKey = errors.New("seed does not generate a valid master key")
+	// ErrHardenedPublicDerivation is returned when deriving a hardened child from a public key.
+	ErrHardenedPublicDerivation = errors.New("cannot derive hardened child from public key")
+)
+
+// ExtendedKey is a BIP32-Ed25519 extended private key, as defined by
+// Khovratovich and Law, which supports both hardened and non-hardened
+// derivation.  Derivation follows the V2 scheme used by Cardano.
+// https://input-output-hk.github.io/adrestia/static/Ed25519_BIP.pdf
+type ExtendedKey struct {
+	kL        []byte
+	kR        []byte
+	chainCode []byte
+}
+
+// ExtendedPublicKey is a BIP32-Ed25519 extended public key, which supports
+// non-hardened derivation.
+type ExtendedPublicKey struct {
+	key       []byte
+	chainCode []byte
+}
+
+// ExtendedMasterKeyFromSeed generates a BIP32-Ed25519 master key given a seed.
+// As per the specification, seeds whose hash does not have the third highest
+// bit clear are rejected with ErrInvalidMasterKey.
+func ExtendedMasterKeyFromSeed(seed []byte) (*ExtendedKey, error) {
+	if err := checkSeedLen(seed); err != nil {
+		return nil, err
+	}
+
+	k := sha512.Sum512(seed)
+	if k[31]&0x20 != 0 {
+		return nil, ErrInvalidMasterKey
+	}
+	clampExtendedKey(k[:])
+	chainCode := sha256.Sum256(append([]byte{0x01}, seed...))
+
+	return &ExtendedKey{
+		kL:        k[:32],
+		kR:        k[32:],
+		chainCode: chainCode[:],
+	}, nil
+}
+
+// NewExtendedKey creates an extended private key from its 96-byte
+// serialisation kL || kR || chain code.
+func NewExtendedKey(data []byte) (*ExtendedKey, error) {
+	if len(data) != 96 {
+		return nil, fmt.Errorf("extended key must be 96 bytes (passed %d)", len(data))
+	}
+	if data[0]&0x07 != 0 || data[31]&0xc0 != 0x40 {
+		return nil, errors.New("extended key is not clamped")
+	}
+
+	return &ExtendedKey{
+		kL:        append([]byte{}, data[:32]...),
+		kR:        append([]byte{}, data[32:64]...),
+		chainCode: append([]byte{}, data[64:]...),
+	}, nil
+}
+
+// clampExtendedKey clears the lowest 3 bits and highest bit, and sets the
+// second highest bit, of the left half of an extended key.
+func clampExtendedKey(k []byte) {
+	k[0] &= 0xf8
+	k[31] &= 0x7f
+	k[31] |= 0x40
+}
+
+// Bytes returns the 96-byte serialisation kL || kR || chain code.
+func (k *ExtendedKey) Bytes() []byte {
+	res := make([]byte, 0, 96)
+	res = append(res, k.kL...)
+	res = append(res, k.kR...)
+
+	return append(res, k.chainCode...)
+}
+
+// PublicKey returns the Ed25519 public key.
+func (k *ExtendedKey) PublicKey() []byte {
+	return edwards25519.NewGeneratorPoint().ScalarBaseMult(k.scalar()).Bytes()
+}
+
+// ExtendedPublicKey returns the extended public key.
+func (k *ExtendedKey) ExtendedPublicKey() *ExtendedPublicKey {
+	return &ExtendedPublicKey{
+		key:       k.PublicKey(),
+		chainCode: append([]byte{}, k.chainCode...),
+	}
+}
+
+// scalar returns kL reduced to a scalar.
+func (k *ExtendedKey) scalar() *edwards25519.Scalar {
+	wide := make([]byte, 64)
+	copy(wide, k.kL)
+	// Cannot fail, as the input is 64 bytes.
+	scalar, _ := edwards25519.NewScalar().SetUniformBytes(wide)
+
+	return scalar
+}
+
+// Child derives a child key.  Indices at or above 0x80000000 are hardened.
+func (k *ExtendedKey) Child(index uint32) (*ExtendedKey, error) {
+	iBytes := make([]byte, 4)
+	binary.LittleEndian.PutUint32(iBytes, index)
+
+	var zData, cData []byte
+	if index >= hardenedOffset {
+		zData = append(append([]byte{0x00}, k.kL...), k.kR...)
+		cData = append(append([]byte{0x01}, k.kL...), k.kR...)
+	} else {
+		pubKey := k.PublicKey()
+		zData = append([]byte{0x02}, pubKey...)
+		cData = append([]byte{0x03}, pubKey...)
+	}
+	z, err := hmacSHA512(k.chainCode, append(zData, iBytes...))
+	if err != nil {
+		return nil, err
+	}
+	c, err := hmacSHA512(k.chainCode, append(cData, iBytes...))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExtendedKey{
+		kL:        addLE(k.kL, mul8LE(z[:28])),
+		kR:        addLE(k.kR, z[32:]),
+		chainCode: c[32:],
+	}, nil
+}
+
+// Sign signs a message, producing a standard Ed25519 signature.
+func (k *ExtendedKey) Sign(msg []byte) []byte {
+	pubKey := k.PublicKey()
+
+	rHash := sha512.Sum512(append(append([]byte{}, k.kR...), msg...))
+	// Cannot fail, as the input is 64 bytes.
+	r, _ := edwards25519.NewScalar().SetUniformBytes(rHash[:])
+	rPoint := edwards25519.NewGeneratorPoint().ScalarBaseMult(r).Bytes()
+
+	hramHash := sha512.Sum512(append(append(append([]byte{}, rPoint...), pubKey...), msg...))
+	hram, _ := edwards25519.NewScalar().SetUniformBytes(hramHash[:])
+	s := edwards25519.NewScalar().MultiplyAdd(hram, k.scalar(), r)
+
+	return append(rPoint, s.Bytes()...)
+}
+
+// NewExtendedPublicKey creates an extended public key from its 64-byte
+// serialisation public key || chain code.
+func NewExtendedPublicKey(data []byte) (*ExtendedPublicKey, error) {
+	if len(data) != 64 {
+		return nil, fmt.Errorf("extended public key must be 64 bytes (passed %d)", len(data))
+	}
+	if _, err := new(edwards25519.Point).SetBytes(data[:32]); err != nil {
+		return nil, errors.Wrap(err, "invalid public key")
+	}
+
+	return &ExtendedPublicKey{
+		key:       append([]byte{}, data[:32]...),
+		chainCode: append([]byte{}, data[32:]...),
+	}, nil
+}
+
+// Bytes returns the 64-byte serialisation public key || chain code.
+func (p *ExtendedPublicKey) Bytes() []byte {
+	return append(append([]byte{}, p.key...), p.chainCode...)
+}
+
+// PublicKey returns the Ed25519 public key.
+func (p *ExtendedPublicKey) PublicKey() []byte {
+	return append([]byte{}, p.key...)
+}
+
+// Child derives a non-hardened child public key.
+func (p *ExtendedPublicKey) Child(index uint32) (*ExtendedPublicKey, error) {
+	if index >= hardenedOffset {
+		return nil, ErrHardenedPublicDerivation
+	}
+
+	iBytes := make([]byte, 4)
+	binary.LittleEndian.PutUint32(iBytes, index)
+	z, err := hmacSHA512(p.chainCode, append(append([]byte{0x02}, p.key...), iBytes...))
+	if err != nil {
+		return nil, err
+	}
+	c, err := hmacSHA512(p.chainCode, append(append([]byte{0x03}, p.key...), iBytes...))
+	if err != nil {
+		return nil, err
+	}
+
+	point, err := new(edwards25519.Point).SetBytes(p.key)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid public key")
+	}
+	// 8 * zL is below 2^227 so is a canonical scalar.
+	zL, err := edwards25519.NewScalar().SetCanonicalBytes(mul8LE(z[:28]))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid child scalar")
+	}
+	point.Add(point, edwards25519.NewGeneratorPoint().ScalarBaseMult(zL))
+
+	return &ExtendedPublicKey{
+		key:       point.Bytes(),
+		chainCode: c[32:],
+	}, nil
+}
+
+// DeriveExtendedKey derives an extended private key from a parent key and a
+// path of any depth, such as m/1852'/1815'/0'/0/0.
+func DeriveExtendedKey(key *ExtendedKey, path string) (*ExtendedKey, error) {
+	indices, err := indicesForBIP32Path(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indices {
+		key, err = key.Child(index)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+// DeriveExtendedPublicKey derives an extended public key from a parent key
+// and a path of non-hardened elements, such as m/0/5.
+func DeriveExtendedPublicKey(key *ExtendedPublicKey, path string) (*ExtendedPublicKey, error) {
+	indices, err := indicesForBIP32Path(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indices {
+		key, err = key.Child(index)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+func hmacSHA512(key []byte, data []byte) ([]byte, error) {
+	mac := hmac.New(sha512.New, key)
+	_, err := mac.Write(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write data")
+	}
+
+	return mac.Sum(nil), nil
+}
+
+// mul8LE returns 8 times a little-endian integer, as 32 bytes.
+func mul8LE(x []byte) []byte {
+	res := make([]byte, 32)
+	carry := byte(0)
+	for i := range x {
+		res[i] = x[i]<<3 | carry
+		carry = x[i] >> 5
+	}
+	res[len(x)] = carry
+
+	return res
+}
+
+// addLE returns the sum of two 32-byte little-endian integers modulo 2^256.
+func addLE(x []byte, y []byte) []byte {
+	res := make([]byte, 32)
+	carry := uint16(0)
+	for i := range res {
+		sum := uint16(x[i]) + uint16(y[i]) + carry
+		res[i] = byte(sum)
+		carry = sum >> 8
+	}
+
+	return res
+}
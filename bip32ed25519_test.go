@@ -0,0 +1,152 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ed25519"
+)
+
+func TestExtendedMasterKeyFromSeed(t *testing.T) {
+	type test struct {
+		name string
+		seed []byte
+		err  string
+	}
+
+	tests := []test{
+		{
+			name: "Empty",
+			err:  "seed must be between 16 and 64 bytes (passed 0)",
+		},
+		{
+			name: "Rejected",
+			seed: bytes.Repeat([]byte{0x01}, 32),
+			err:  "seed does not generate a valid master key",
+		},
+		{
+			name: "Good",
+			seed: bytes.Repeat([]byte{0x00}, 32),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := ExtendedMasterKeyFromSeed(test.seed)
+			if test.err == "" {
+				require.NoError(t, err)
+				data := key.Bytes()
+				require.Len(t, data, 96)
+				require.Equal(t, byte(0), data[0]&0x07)
+				require.Equal(t, byte(0x40), data[31]&0xe0)
+				parsed, err := NewExtendedKey(data)
+				require.NoError(t, err)
+				require.Equal(t, key, parsed)
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestNewExtendedKey(t *testing.T) {
+	_, err := NewExtendedKey(make([]byte, 95))
+	require.EqualError(t, err, "extended key must be 96 bytes (passed 95)")
+	_, err = NewExtendedKey(make([]byte, 96))
+	require.EqualError(t, err, "extended key is not clamped")
+
+	_, err = NewExtendedPublicKey(make([]byte, 63))
+	require.EqualError(t, err, "extended public key must be 64 bytes (passed 63)")
+}
+
+func TestExtendedKeyDerivation(t *testing.T) {
+	root, err := ExtendedMasterKeyFromSeed(bytes.Repeat([]byte{0x00}, 32))
+	require.NoError(t, err)
+
+	account, err := DeriveExtendedKey(root, "m/1852'/1815'/0'")
+	require.NoError(t, err)
+	accountPub := account.ExtendedPublicKey()
+
+	// Non-hardened children derived from the public key match those derived from the private key.
+	for _, path := range []string{"m/0", "m/0/0", "m/1/5", "m/2147483647"} {
+		priv, err := DeriveExtendedKey(account, path)
+		require.NoError(t, err)
+		pub, err := DeriveExtendedPublicKey(accountPub, path)
+		require.NoError(t, err)
+		require.Equal(t, priv.ExtendedPublicKey(), pub, path)
+		require.Equal(t, priv.PublicKey(), pub.PublicKey())
+	}
+
+	// Hardened and non-hardened children differ.
+	soft, err := account.Child(0)
+	require.NoError(t, err)
+	hard, err := account.Child(0x80000000)
+	require.NoError(t, err)
+	require.NotEqual(t, soft.PublicKey(), hard.PublicKey())
+
+	_, err = DeriveExtendedPublicKey(accountPub, "m/0'")
+	require.EqualError(t, err, "cannot derive hardened child from public key")
+	_, err = DeriveExtendedKey(account, "m/a")
+	require.EqualError(t, err, "invalid path")
+	_, err = DeriveExtendedKey(account, "m/2147483648")
+	require.EqualError(t, err, "hardened path element cannot be larger than 2147483647")
+
+	parsed, err := NewExtendedPublicKey(accountPub.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, accountPub, parsed)
+}
+
+// The expected public key is the payment verification key of the CIP-0019
+// test vectors, addr_vk1w0l2sr2zgfm26ztc6nl9xy8ghsk5sh6ldwemlpmp9xylzy4dtf7st80zhd,
+// which is derived with three hardened and two non-hardened levels.  Master
+// keys are tested against the CIP-0003 vectors in TestCardanoMasterKey.
+func TestExtendedKeyKnownAnswers(t *testing.T) {
+	expected := _strToHex("73fea80d424276ad0978d4fe5310e8bc2d485f5f6bb3bf87612989f112ad5a7d")
+
+	root, err := CardanoMasterKey(CardanoIcarus, "test walk nut penalty hip pave soap entry language right filter choice", "")
+	require.NoError(t, err)
+	key, err := DeriveExtendedKey(root, "m/1852'/1815'/0'/0/0")
+	require.NoError(t, err)
+	require.Equal(t, expected, key.PublicKey())
+
+	// Deriving the non-hardened levels from the account public key gives the same key.
+	account, err := DeriveExtendedKey(root, "m/1852'/1815'/0'")
+	require.NoError(t, err)
+	pub, err := DeriveExtendedPublicKey(account.ExtendedPublicKey(), "m/0/0")
+	require.NoError(t, err)
+	require.Equal(t, expected, pub.PublicKey())
+}
+
+func TestExtendedKeySign(t *testing.T) {
+	root, err := ExtendedMasterKeyFromSeed(bytes.Repeat([]byte{0x00}, 32))
+	require.NoError(t, err)
+	key, err := DeriveExtendedKey(root, "m/1852'/1815'/0'/0/0")
+	require.NoError(t, err)
+
+	msg := []byte("message")
+	sig := key.Sign(msg)
+	require.Len(t, sig, 64)
+	require.True(t, ed25519.Verify(key.PublicKey(), msg, sig))
+	require.False(t, ed25519.Verify(root.PublicKey(), msg, sig))
+}
+
+func TestExtendedKeyArithmetic(t *testing.T) {
+	require.Equal(t, _strToHex("0800000000000000000000000000000000000000000000000000000000000000"), mul8LE(_strToHex("01000000000000000000000000000000000000000000000000000000")))
+	require.Equal(t, _strToHex("f8ffffffffffffffffffffffffffffffffffffffffffffffffffffff07000000"), mul8LE(_strToHex("ffffffffffffffffffffffffffffffffffffffffffffffffffffffff")))
+	require.Equal(t, _strToHex("0000000000000000000000000000000000000000000000000000000000000001"), addLE(_strToHex("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00"), _strToHex("0100000000000000000000000000000000000000000000000000000000000000")))
+	require.Equal(t, make([]byte, 32), addLE(bytes.Repeat([]byte{0xff}, 32), _strToHex("0100000000000000000000000000000000000000000000000000000000000000")))
+}
@@ -0,0 +1,155 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// BIP44Purpose is the purpose used by BIP-44 paths.
+const BIP44Purpose = uint32(44)
+
+// ErrHardenedElementTooLarge is returned when a path element is too large to be hardened.
+var ErrHardenedElementTooLarge = errors.New("hardened path element cannot be larger than 2147483647")
+
+// BIP44 is a typed BIP-44 derivation path of the form
+// m/purpose'/coin_type'/account'[/change[/index]].
+type BIP44 struct {
+	Purpose  uint32
+	CoinType uint32
+	Account  uint32
+	// Change is the optional change level.
+	Change *uint32
+	// Index is the optional index level, which requires Change.
+	Index *uint32
+}
+
+// NewBIP44 creates a BIP-44 path for the given coin type and account.
+func NewBIP44(coinType uint32, account uint32) *BIP44 {
+	return &BIP44{
+		Purpose:  BIP44Purpose,
+		CoinType: coinType,
+		Account:  account,
+	}
+}
+
+// WithChange returns a copy of the path with the given change level and no index.
+func (b *BIP44) WithChange(change uint32) *BIP44 {
+	return &BIP44{
+		Purpose:  b.Purpose,
+		CoinType: b.CoinType,
+		Account:  b.Account,
+		Change:   &change,
+	}
+}
+
+// WithIndex returns a copy of the path with the given change and index levels.
+func (b *BIP44) WithIndex(change uint32, index uint32) *BIP44 {
+	return &BIP44{
+		Purpose:  b.Purpose,
+		CoinType: b.CoinType,
+		Account:  b.Account,
+		Change:   &change,
+		Index:    &index,
+	}
+}
+
+// Path returns the validated string form of the path.
+func (b *BIP44) Path() (string, error) {
+	if b.Index != nil && b.Change == nil {
+		return "", errors.New("index requires change")
+	}
+
+	elements := []uint32{b.Purpose, b.CoinType, b.Account}
+	if b.Change != nil {
+		elements = append(elements, *b.Change)
+	}
+	if b.Index != nil {
+		elements = append(elements, *b.Index)
+	}
+
+	for _, element := range elements {
+		// All elements are hardened when derived.
+		if element >= hardenedOffset {
+			return "", ErrHardenedElementTooLarge
+		}
+	}
+	path := pathForElements(elements)
+
+	if !isValidPath(path) {
+		return "", ErrInvalidPath
+	}
+
+	return path, nil
+}
+
+// String returns the string form of the path, or an empty string if the path is invalid.
+func (b *BIP44) String() string {
+	path, err := b.Path()
+	if err != nil {
+		return ""
+	}
+
+	return path
+}
+
+// ParseBIP44 parses a path of the form m/purpose'/coin_type'/account'[/change[/index]].
+// The coin type can be given by name.
+func ParseBIP44(path string) (*BIP44, error) {
+	path, err := resolveCoinType(path)
+	if err != nil {
+		return nil, err
+	}
+	if !isValidPath(path) {
+		return nil, ErrInvalidPath
+	}
+	elements, err := elementsForPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(elements) < 3 {
+		return nil, fmt.Errorf("BIP-44 path requires at least 3 elements (found %d)", len(elements))
+	}
+	for _, element := range elements {
+		if element >= hardenedOffset {
+			return nil, ErrHardenedElementTooLarge
+		}
+	}
+
+	res := &BIP44{
+		Purpose:  elements[0],
+		CoinType: elements[1],
+		Account:  elements[2],
+	}
+	if len(elements) > 3 {
+		res.Change = &elements[3]
+	}
+	if len(elements) > 4 {
+		res.Index = &elements[4]
+	}
+
+	return res, nil
+}
+
+// DeriveBIP44Key derives a key given a seed and a BIP-44 path.
+func DeriveBIP44Key(seed []byte, path *BIP44) (*Key, error) {
+	pathStr, err := path.Path()
+	if err != nil {
+		return nil, err
+	}
+
+	return DeriveKey(seed, pathStr)
+}
@@ -0,0 +1,126 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBIP44Path(t *testing.T) {
+	one := uint32(1)
+
+	type test struct {
+		name string
+		bip  *BIP44
+		err  string
+		path string
+	}
+
+	tests := []test{
+		{
+			name: "Account",
+			bip:  NewBIP44(1901, 0),
+			path: "m/44'/1901'/0'",
+		},
+		{
+			name: "Change",
+			bip:  NewBIP44(1901, 0).WithChange(0),
+			path: "m/44'/1901'/0'/0",
+		},
+		{
+			name: "Index",
+			bip:  NewBIP44(1901, 0).WithIndex(0, 1),
+			path: "m/44'/1901'/0'/0/1",
+		},
+		{
+			name: "IndexWithoutChange",
+			bip: &BIP44{
+				Purpose:  44,
+				CoinType: 1901,
+				Index:    &one,
+			},
+			err: "index requires change",
+		},
+		{
+			name: "TooLarge",
+			bip:  NewBIP44(1901, 0x80000000),
+			err:  "hardened path element cannot be larger than 2147483647",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path, err := test.bip.Path()
+			if test.err == "" {
+				require.NoError(t, err)
+				require.Equal(t, test.path, path)
+				require.Equal(t, test.path, test.bip.String())
+				parsed, err := ParseBIP44(path)
+				require.NoError(t, err)
+				require.Equal(t, test.bip, parsed)
+			} else {
+				require.EqualError(t, err, test.err)
+				require.Equal(t, "", test.bip.String())
+			}
+		})
+	}
+}
+
+func TestParseBIP44(t *testing.T) {
+	type test struct {
+		name string
+		path string
+		err  string
+	}
+
+	tests := []test{
+		{
+			name: "Invalid",
+			path: "m/44'/1901'/0'/0'",
+			err:  "invalid path",
+		},
+		{
+			name: "Short",
+			path: "m/44'/1901'",
+			err:  "BIP-44 path requires at least 3 elements (found 2)",
+		},
+		{
+			name: "TooLarge",
+			path: "m/44'/2147483648'/0'",
+			err:  "hardened path element cannot be larger than 2147483647",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseBIP44(test.path)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestDeriveBIP44Key(t *testing.T) {
+	seed := _strToHex("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000")
+
+	key, err := DeriveBIP44Key(seed, NewBIP44(1901, 0).WithIndex(0, 1))
+	require.NoError(t, err)
+	pubKey, err := key.PublicKey()
+	require.NoError(t, err)
+	require.Equal(t, _strToHex("acbe855bd3966736a2dbe8f537b2e52566d719578b92dd2f78be4af5f3c769e7"), pubKey)
+
+	_, err = DeriveBIP44Key(seed, NewBIP44(0x80000000, 0))
+	require.EqualError(t, err, "hardened path element cannot be larger than 2147483647")
+}
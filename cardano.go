@@ -0,0 +1,112 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+// CardanoMode is an algorithm to generate a Cardano BIP32-Ed25519 master key
+// from a mnemonic.
+// https://github.com/cardano-foundation/CIPs/blob/master/CIP-0003/CIP-0003.md
+type CardanoMode string
+
+const (
+	// CardanoIcarus is the algorithm used by most software wallets.
+	CardanoIcarus CardanoMode = "icarus"
+	// CardanoLedger is the algorithm used by Ledger hardware wallets.
+	CardanoLedger CardanoMode = "ledger"
+	// CardanoTrezor is the algorithm used by Trezor hardware wallets, which
+	// differs from Icarus for 24-word mnemonics.
+	CardanoTrezor CardanoMode = "trezor"
+)
+
+// ErrUnknownCardanoMode is returned when a Cardano mode is not known.
+var ErrUnknownCardanoMode = errors.New("unknown Cardano mode")
+
+// CardanoMasterKey generates a Cardano BIP32-Ed25519 master key from a
+// mnemonic of 12 to 24 words and a passphrase, using the given mode.
+func CardanoMasterKey(mode CardanoMode, mnemonic string, passphrase string) (*ExtendedKey, error) {
+	mnemonic = norm.NFKD.String(mnemonic)
+	passphrase = norm.NFKD.String(passphrase)
+	words := strings.Split(mnemonic, " ")
+	entropy, err := entropyFromWords(words)
+	if err != nil {
+		return nil, err
+	}
+
+	switch mode {
+	case CardanoIcarus:
+		return icarusMasterKey(entropy, passphrase), nil
+	case CardanoTrezor:
+		if len(words) == 24 {
+			// Trezor includes the checksum byte with the entropy.
+			checksum := sha256.Sum256(entropy)
+			entropy = append(entropy, checksum[0])
+		}
+		return icarusMasterKey(entropy, passphrase), nil
+	case CardanoLedger:
+		seed := pbkdf2.Key([]byte(mnemonic), []byte("mnemonic"+passphrase), 2048, 64, sha512.New)
+		return ledgerMasterKey(seed)
+	default:
+		return nil, errors.Wrap(ErrUnknownCardanoMode, string(mode))
+	}
+}
+
+func icarusMasterKey(entropy []byte, passphrase string) *ExtendedKey {
+	k := pbkdf2.Key([]byte(passphrase), entropy, 4096, 96, sha512.New)
+	k[0] &= 0xf8
+	k[31] &= 0x1f
+	k[31] |= 0x40
+
+	return &ExtendedKey{
+		kL:        k[:32],
+		kR:        k[32:64],
+		chainCode: k[64:],
+	}
+}
+
+func ledgerMasterKey(seed []byte) (*ExtendedKey, error) {
+	k, err := hmacSHA512([]byte(masterKeySalt), seed)
+	if err != nil {
+		return nil, err
+	}
+	// Rehash until the third highest bit is clear.
+	for k[31]&0x20 != 0 {
+		k, err = hmacSHA512([]byte(masterKeySalt), k)
+		if err != nil {
+			return nil, err
+		}
+	}
+	clampExtendedKey(k)
+
+	mac := hmac.New(sha256.New, []byte(masterKeySalt))
+	_, err = mac.Write(append([]byte{0x01}, seed...))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write seed")
+	}
+
+	return &ExtendedKey{
+		kL:        k[:32],
+		kR:        k[32:],
+		chainCode: mac.Sum(nil),
+	}, nil
+}
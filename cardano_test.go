@@ -0,0 +1,118 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardanoMasterKey(t *testing.T) {
+	type test struct {
+		name       string
+		mode       CardanoMode
+		mnemonic   string
+		passphrase string
+		err        string
+		key        []byte
+	}
+
+	tests := []test{
+		{
+			name:     "UnknownMode",
+			mode:     "unknown",
+			mnemonic: "eight country switch draw meat scout mystery blade tip drift useless good keep usage title",
+			err:      "unknown: unknown Cardano mode",
+		},
+		{
+			name:     "InvalidMnemonic",
+			mode:     CardanoIcarus,
+			mnemonic: "eight country switch",
+			err:      "mnemonic must be 12, 15, 18, 21 or 24 words (found 3)",
+		},
+		{
+			name:     "Icarus",
+			mode:     CardanoIcarus,
+			mnemonic: "eight country switch draw meat scout mystery blade tip drift useless good keep usage title",
+			key:      _strToHex("c065afd2832cd8b087c4d9ab7011f481ee1e0721e78ea5dd609f3ab3f156d245d176bd8fd4ec60b4731c3918a2a72a0226c0cd119ec35b47e4d55884667f552a23f7fdcd4a10c6cd2c7393ac61d877873e248f417634aa3d812af327ffe9d620"),
+		},
+		{
+			name:       "IcarusPassphrase",
+			mode:       CardanoIcarus,
+			mnemonic:   "eight country switch draw meat scout mystery blade tip drift useless good keep usage title",
+			passphrase: "foo",
+			key:        _strToHex("70531039904019351e1afb361cd1b312a4d0565d4ff9f8062d38acf4b15cce41d7b5738d9c893feea55512a3004acb0d222c35d3e3d5cde943a15a9824cbac59443cf67e589614076ba01e354b1a432e0e6db3b59e37fc56b5fb0222970a010e"),
+		},
+		{
+			name:     "Ledger",
+			mode:     CardanoLedger,
+			mnemonic: "correct cherry mammal bubble want mandate polar hazard crater better craft exotic choice fun tourist census gap lottery neglect address glow carry old business",
+			key:      _strToHex("587c6774357ecbf840d4db6404ff7af016dace0400769751ad2abfc77b9a3844cc71702520ef1a4d1b68b91187787a9b8faab0a9bb6b160de541b6ee62469901fc0beda0975fe4763beabd83b7051a5fd5cbce5b88e82c4bbaca265014e524bd"),
+		},
+		{
+			name:       "LedgerPassphrase",
+			mode:       CardanoLedger,
+			mnemonic:   "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+			passphrase: "foo",
+			key:        _strToHex("f053a1e752de5c26197b60f032a4809f08bb3e5d90484fe42024be31efcba7578d914d3ff992e21652fee6a4d99f6091006938fac2c0c0f9d2de0ba64b754e92a4f3723f23472077aa4cd4dd8a8a175dba07ea1852dad1cf268c61a2679c3890"),
+		},
+		{
+			// Computed with Python's hashlib.pbkdf2_hmac over the entropy and
+			// checksum byte, as CIP-0003 has no Trezor vector.
+			name:     "Trezor24Words",
+			mode:     CardanoTrezor,
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+			key:      _strToHex("60e4d66a4ac3f3abdfbabc56a451fe52b265d574879276859d47f03a964a8d5246069e680f9290ba8cbcc30194d9687cb63d8def4fd00d1a308a4c318bcb4e7451b8b2cde121e8cfb436804ce4b9dd181860de0fcc3500517fbcf3e6fe7bdbf1"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := CardanoMasterKey(test.mode, test.mnemonic, test.passphrase)
+			if test.err == "" {
+				require.NoError(t, err)
+				require.Equal(t, test.key, key.Bytes())
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestCardanoMasterKeyModes(t *testing.T) {
+	mnemonic15 := "eight country switch draw meat scout mystery blade tip drift useless good keep usage title"
+	mnemonic24 := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art"
+
+	// Trezor matches Icarus other than for 24-word mnemonics.
+	icarus, err := CardanoMasterKey(CardanoIcarus, mnemonic15, "")
+	require.NoError(t, err)
+	trezor, err := CardanoMasterKey(CardanoTrezor, mnemonic15, "")
+	require.NoError(t, err)
+	require.Equal(t, icarus.Bytes(), trezor.Bytes())
+
+	icarus, err = CardanoMasterKey(CardanoIcarus, mnemonic24, "")
+	require.NoError(t, err)
+	trezor, err = CardanoMasterKey(CardanoTrezor, mnemonic24, "")
+	require.NoError(t, err)
+	require.NotEqual(t, icarus.Bytes(), trezor.Bytes())
+
+	// All modes generate valid extended keys.
+	for _, mode := range []CardanoMode{CardanoIcarus, CardanoLedger, CardanoTrezor} {
+		key, err := CardanoMasterKey(mode, mnemonic24, "foo")
+		require.NoError(t, err)
+		_, err = NewExtendedKey(key.Bytes())
+		require.NoError(t, err)
+	}
+}
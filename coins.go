@@ -0,0 +1,161 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrUnknownCoinType is returned when a coin type is not in the registry.
+var ErrUnknownCoinType = errors.New("unknown coin type")
+
+// registeredCoin is an entry in the SLIP-0044 registry of coin types.
+type registeredCoin struct {
+	// Index is the coin type used in derivation paths.
+	Index uint32
+	// Name is the lower-case name of the coin.
+	Name string
+	// Symbol is the ticker symbol of the coin.
+	Symbol string
+}
+
+// coins are the SLIP-0044 registered coin types of chains that use Ed25519.
+// https://github.com/satoshilabs/slips/blob/master/slip-0044.md
+var coins = []*registeredCoin{
+	{Index: 1, Name: "testnet", Symbol: ""},
+	{Index: 43, Name: "nem", Symbol: "XEM"},
+	{Index: 148, Name: "stellar", Symbol: "XLM"},
+	{Index: 165, Name: "nano", Symbol: "XNO"},
+	{Index: 283, Name: "algorand", Symbol: "ALGO"},
+	{Index: 354, Name: "polkadot", Symbol: "DOT"},
+	{Index: 397, Name: "near", Symbol: "NEAR"},
+	{Index: 434, Name: "kusama", Symbol: "KSM"},
+	{Index: 501, Name: "solana", Symbol: "SOL"},
+	{Index: 508, Name: "multiversx", Symbol: "EGLD"},
+	{Index: 607, Name: "ton", Symbol: "TON"},
+	{Index: 637, Name: "aptos", Symbol: "APT"},
+	{Index: 784, Name: "sui", Symbol: "SUI"},
+	{Index: 1729, Name: "tezos", Symbol: "XTZ"},
+	{Index: 1815, Name: "cardano", Symbol: "ADA"},
+	{Index: 3030, Name: "hedera", Symbol: "HBAR"},
+	{Index: 4218, Name: "iota", Symbol: "IOTA"},
+	{Index: 4343, Name: "symbol", Symbol: "XYM"},
+}
+
+var (
+	coinsByName  = map[string]*registeredCoin{}
+	coinsByIndex = map[uint32]*registeredCoin{}
+)
+
+func init() {
+	for _, coin := range coins {
+		coinsByName[coin.Name] = coin
+		if coin.Symbol != "" {
+			coinsByName[strings.ToLower(coin.Symbol)] = coin
+		}
+		coinsByIndex[coin.Index] = coin
+	}
+}
+
+// CoinType returns the coin type for a coin, given its name or symbol.
+func CoinType(name string) (uint32, error) {
+	coin, exists := coinsByName[strings.ToLower(name)]
+	if !exists {
+		return 0, errors.Wrap(ErrUnknownCoinType, name)
+	}
+
+	return coin.Index, nil
+}
+
+// CoinName returns the name of a coin, given its coin type.
+func CoinName(index uint32) (string, error) {
+	coin, exists := coinsByIndex[index]
+	if !exists {
+		return "", errors.Wrap(ErrUnknownCoinType, fmt.Sprintf("%d", index))
+	}
+
+	return coin.Name, nil
+}
+
+// NewBIP44ForCoin creates a BIP-44 path for the given coin name and account.
+func NewBIP44ForCoin(name string, account uint32) (*BIP44, error) {
+	coinType, err := CoinType(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBIP44(coinType, account), nil
+}
+
+// CheckCoinType returns ErrUnknownCoinType if the coin type of a path is not
+// in the registry.  A coin name that is not in the registry makes the path
+// invalid, and returns ErrInvalidPath.
+func CheckCoinType(path string) error {
+	path, err := resolveCoinType(path)
+	if err != nil {
+		return err
+	}
+	if !isValidPath(path) {
+		return ErrInvalidPath
+	}
+	elements, err := elementsForPath(path)
+	if err != nil {
+		return err
+	}
+	if len(elements) < 2 {
+		return errors.New("path does not contain a coin type")
+	}
+	if _, exists := coinsByIndex[elements[1]]; !exists {
+		return errors.Wrap(ErrUnknownCoinType, fmt.Sprintf("%d", elements[1]))
+	}
+
+	return nil
+}
+
+// DeriveKeyStrict derives a key given a seed and a derivation path, as per
+// DeriveKey, but additionally requires the coin type of the path to be in the
+// registry.
+func DeriveKeyStrict(seed []byte, path string) (*Key, error) {
+	if err := CheckCoinType(path); err != nil {
+		return nil, err
+	}
+
+	return DeriveKey(seed, path)
+}
+
+// resolveCoinType replaces a coin name in the coin type position of a path
+// with its coin type, for example m/44'/solana'/0' becomes m/44'/501'/0'.
+// Unknown names return ErrInvalidPath, as the path would before resolution.
+func resolveCoinType(path string) (string, error) {
+	elements := strings.Split(path, "/")
+	if len(elements) < 3 {
+		return path, nil
+	}
+
+	name := strings.TrimSuffix(elements[2], "'")
+	if name == "" || strings.Trim(name, "0123456789") == "" {
+		// Empty or numeric.
+		return path, nil
+	}
+	coinType, err := CoinType(name)
+	if err != nil {
+		return "", ErrInvalidPath
+	}
+	elements[2] = strings.Replace(elements[2], name, fmt.Sprintf("%d", coinType), 1)
+
+	return strings.Join(elements, "/"), nil
+}
@@ -0,0 +1,156 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoinType(t *testing.T) {
+	type test struct {
+		name     string
+		coinType uint32
+		err      string
+	}
+
+	tests := []test{
+		{
+			name: "Unknown",
+			err:  "Unknown: unknown coin type",
+		},
+		{
+			name:     "solana",
+			coinType: 501,
+		},
+		{
+			name:     "Stellar",
+			coinType: 148,
+		},
+		{
+			name:     "XLM",
+			coinType: 148,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			coinType, err := CoinType(test.name)
+			if test.err == "" {
+				require.NoError(t, err)
+				require.Equal(t, test.coinType, coinType)
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestCoinName(t *testing.T) {
+	name, err := CoinName(501)
+	require.NoError(t, err)
+	require.Equal(t, "solana", name)
+
+	_, err = CoinName(1901)
+	require.EqualError(t, err, "1901: unknown coin type")
+	require.True(t, errors.Is(err, ErrUnknownCoinType))
+}
+
+func TestDeriveKeyCoinName(t *testing.T) {
+	seed := _strToHex("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000")
+
+	named, err := DeriveKey(seed, "m/44'/solana'/0'/0")
+	require.NoError(t, err)
+	numbered, err := DeriveKey(seed, "m/44'/501'/0'/0")
+	require.NoError(t, err)
+	require.Equal(t, numbered, named)
+
+	_, err = DeriveKey(seed, "m/44'/unknown'/0'")
+	require.Equal(t, ErrInvalidPath, err)
+	require.True(t, errors.Is(err, ErrInvalidPath))
+
+	// Malformed coin types remain invalid paths.
+	_, err = DeriveKey(seed, "m/44'/1x'/0'")
+	require.Equal(t, ErrInvalidPath, err)
+
+	bip, err := NewBIP44ForCoin("solana", 0)
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/501'/0'", bip.String())
+
+	bip, err = ParseBIP44("m/44'/stellar'/1'")
+	require.NoError(t, err)
+	require.Equal(t, uint32(148), bip.CoinType)
+}
+
+func TestCheckCoinType(t *testing.T) {
+	type test struct {
+		name string
+		path string
+		err  string
+	}
+
+	tests := []test{
+		{
+			name: "Invalid",
+			path: "m/44",
+			err:  "invalid path",
+		},
+		{
+			name: "NoCoinType",
+			path: "m/44'",
+			err:  "path does not contain a coin type",
+		},
+		{
+			name: "Unknown",
+			path: "m/44'/1901'/0'",
+			err:  "1901: unknown coin type",
+		},
+		{
+			name: "UnknownName",
+			path: "m/44'/unknown'/0'",
+			err:  "invalid path",
+		},
+		{
+			name: "Known",
+			path: "m/44'/501'/0'",
+		},
+		{
+			name: "Named",
+			path: "m/44'/solana'/0'",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckCoinType(test.path)
+			if test.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestDeriveKeyStrict(t *testing.T) {
+	seed := _strToHex("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000")
+
+	_, err := DeriveKeyStrict(seed, "m/44'/1901'/0'")
+	require.EqualError(t, err, "1901: unknown coin type")
+
+	_, err = DeriveKeyStrict(seed, "m/44'/solana'/0'")
+	require.NoError(t, err)
+}
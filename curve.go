@@ -0,0 +1,322 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/ed25519"
+)
+
+// Curve is an elliptic curve supported by SLIP-0010 derivation.
+// https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+type Curve interface {
+	// Name returns the SLIP-0010 name of the curve.
+	Name() string
+
+	// salt returns the HMAC key used to generate a master key.
+	salt() string
+	// hardenedOnly returns true if the curve only supports hardened derivation.
+	hardenedOnly() bool
+	// validKey returns true if a 32-byte value is a valid private key.
+	validKey(key []byte) bool
+	// publicKey returns the public key for a private key.
+	publicKey(key []byte) ([]byte, error)
+	// childKey returns the child private key given the parent private key and
+	// the left half of the HMAC output, or false if the child is invalid.
+	childKey(parent []byte, il []byte) ([]byte, bool)
+	// childPublicKey returns the child public key given the parent public key
+	// and the left half of the HMAC output, or false if the child is invalid.
+	childPublicKey(parent []byte, il []byte) ([]byte, bool, error)
+}
+
+var (
+	// Ed25519 is the Ed25519 curve.
+	Ed25519 Curve = &edwardsCurve{name: "ed25519", masterKeySalt: masterKeySalt}
+	// Curve25519 is the Curve25519 curve, with keys for use with X25519.  Public
+	// keys are 32-byte X25519 public keys.
+	Curve25519 Curve = &edwardsCurve{name: "curve25519", masterKeySalt: "curve25519 seed", montgomery: true}
+	// Secp256k1 is the secp256k1 curve used by Bitcoin.
+	Secp256k1 Curve = &weierstrassCurve{
+		name:           "secp256k1",
+		masterKeySalt:  "Bitcoin seed",
+		order:          secp256k1.S256().N,
+		scalarBaseMult: secp256k1ScalarBaseMult,
+		addPoints:      secp256k1AddPoints,
+	}
+	// NIST256p1 is the NIST P-256 curve.
+	NIST256p1 Curve = &weierstrassCurve{
+		name:           "nist256p1",
+		masterKeySalt:  "Nist256p1 seed",
+		order:          elliptic.P256().Params().N,
+		scalarBaseMult: p256ScalarBaseMult,
+		addPoints:      p256AddPoints,
+	}
+
+	// ErrPublicDerivationUnsupported is returned when public derivation is attempted on a curve that does not support it.
+	ErrPublicDerivationUnsupported = errors.New("curve does not support public derivation")
+	// ErrInvalidPublicKey is returned when a public key is not a valid point.
+	ErrInvalidPublicKey = errors.New("invalid public key")
+)
+
+// edwardsCurve is a curve that only supports hardened derivation, with the
+// left half of the HMAC output used directly as the child key.
+type edwardsCurve struct {
+	name          string
+	masterKeySalt string
+	// montgomery is true if public keys are X25519 rather than Ed25519.
+	montgomery bool
+}
+
+func (c *edwardsCurve) Name() string {
+	return c.name
+}
+
+func (c *edwardsCurve) salt() string {
+	return c.masterKeySalt
+}
+
+func (c *edwardsCurve) hardenedOnly() bool {
+	return true
+}
+
+func (c *edwardsCurve) validKey(_ []byte) bool {
+	return true
+}
+
+func (c *edwardsCurve) publicKey(key []byte) ([]byte, error) {
+	if c.montgomery {
+		pub, err := curve25519.X25519(key, curve25519.Basepoint)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate key")
+		}
+
+		return pub, nil
+	}
+
+	pub, _, err := ed25519.GenerateKey(bytes.NewReader(key))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate key")
+	}
+
+	return pub[:], nil
+}
+
+func (c *edwardsCurve) childKey(_ []byte, il []byte) ([]byte, bool) {
+	return il, true
+}
+
+func (c *edwardsCurve) childPublicKey(_ []byte, _ []byte) ([]byte, bool, error) {
+	return nil, false, ErrPublicDerivationUnsupported
+}
+
+// weierstrassCurve is a curve with compressed 33-byte public keys that
+// supports both hardened and public derivation.
+type weierstrassCurve struct {
+	name           string
+	masterKeySalt  string
+	order          *big.Int
+	scalarBaseMult func(key []byte) []byte
+	addPoints      func(a []byte, b []byte) ([]byte, bool, error)
+}
+
+func (c *weierstrassCurve) Name() string {
+	return c.name
+}
+
+func (c *weierstrassCurve) salt() string {
+	return c.masterKeySalt
+}
+
+func (c *weierstrassCurve) hardenedOnly() bool {
+	return false
+}
+
+func (c *weierstrassCurve) validKey(key []byte) bool {
+	k := new(big.Int).SetBytes(key)
+
+	return k.Sign() != 0 && k.Cmp(c.order) < 0
+}
+
+func (c *weierstrassCurve) publicKey(key []byte) ([]byte, error) {
+	return c.scalarBaseMult(key), nil
+}
+
+func (c *weierstrassCurve) childKey(parent []byte, il []byte) ([]byte, bool) {
+	k := new(big.Int).SetBytes(il)
+	if k.Cmp(c.order) >= 0 {
+		return nil, false
+	}
+	k.Add(k, new(big.Int).SetBytes(parent))
+	k.Mod(k, c.order)
+	if k.Sign() == 0 {
+		return nil, false
+	}
+
+	return k.FillBytes(make([]byte, 32)), true
+}
+
+func (c *weierstrassCurve) childPublicKey(parent []byte, il []byte) ([]byte, bool, error) {
+	if k := new(big.Int).SetBytes(il); k.Cmp(c.order) >= 0 {
+		return nil, false, nil
+	}
+
+	return c.addPoints(c.scalarBaseMult(il), parent)
+}
+
+func secp256k1ScalarBaseMult(key []byte) []byte {
+	return secp256k1.PrivKeyFromBytes(key).PubKey().SerializeCompressed()
+}
+
+func secp256k1AddPoints(a []byte, b []byte) ([]byte, bool, error) {
+	pointA, err := secp256k1.ParsePubKey(a)
+	if err != nil {
+		return nil, false, errors.Wrap(ErrInvalidPublicKey, err.Error())
+	}
+	pointB, err := secp256k1.ParsePubKey(b)
+	if err != nil {
+		return nil, false, errors.Wrap(ErrInvalidPublicKey, err.Error())
+	}
+
+	var jacobianA, jacobianB, sum secp256k1.JacobianPoint
+	pointA.AsJacobian(&jacobianA)
+	pointB.AsJacobian(&jacobianB)
+	secp256k1.AddNonConst(&jacobianA, &jacobianB, &sum)
+	if (sum.X.IsZero() && sum.Y.IsZero()) || sum.Z.IsZero() {
+		// Point at infinity.
+		return nil, false, nil
+	}
+	sum.ToAffine()
+
+	return secp256k1.NewPublicKey(&sum.X, &sum.Y).SerializeCompressed(), true, nil
+}
+
+func p256ScalarBaseMult(key []byte) []byte {
+	curve := elliptic.P256()
+	x, y := curve.ScalarBaseMult(key)
+
+	return elliptic.MarshalCompressed(curve, x, y)
+}
+
+func p256AddPoints(a []byte, b []byte) ([]byte, bool, error) {
+	curve := elliptic.P256()
+	ax, ay := elliptic.UnmarshalCompressed(curve, a)
+	if ax == nil {
+		return nil, false, ErrInvalidPublicKey
+	}
+	bx, by := elliptic.UnmarshalCompressed(curve, b)
+	if bx == nil {
+		return nil, false, ErrInvalidPublicKey
+	}
+
+	x, y := curve.Add(ax, ay, bx, by)
+	if x.Sign() == 0 && y.Sign() == 0 {
+		// Point at infinity.
+		return nil, false, nil
+	}
+
+	return elliptic.MarshalCompressed(curve, x, y), true, nil
+}
+
+// DeriveCurveKey derives a key on the given curve from a seed and a BIP-32
+// style path, for example m/0'/1/2'.  Unhardened elements are only allowed
+// for curves that support them.
+func DeriveCurveKey(curve Curve, seed []byte, path string) (*Key, error) {
+	indices, err := indicesForBIP32Path(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := NewMasterKey(curve, seed)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indices {
+		key, err = deriveKey(key, index)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+// NeuteredKey is a public key and chain code, from which unhardened child
+// public keys can be derived without knowledge of the private key.
+type NeuteredKey struct {
+	curve     Curve
+	publicKey []byte
+	chainCode []byte
+}
+
+// Neuter returns the public part of the key for public child derivation.
+func (k *Key) Neuter() (*NeuteredKey, error) {
+	curve := k.Curve()
+	if curve.hardenedOnly() {
+		return nil, ErrPublicDerivationUnsupported
+	}
+	pubKey, err := k.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return &NeuteredKey{
+		curve:     curve,
+		publicKey: pubKey,
+		chainCode: k.ChainCode(),
+	}, nil
+}
+
+// PublicKey returns a copy of the public key.
+func (k *NeuteredKey) PublicKey() []byte {
+	return append([]byte{}, k.publicKey...)
+}
+
+// ChainCode returns a copy of the chain code.
+func (k *NeuteredKey) ChainCode() []byte {
+	return append([]byte{}, k.chainCode...)
+}
+
+// Child derives the unhardened child public key at the given index.
+func (k *NeuteredKey) Child(index uint32) (*NeuteredKey, error) {
+	if index >= hardenedOffset {
+		return nil, ErrHardenedPublicDerivation
+	}
+
+	data := append(append([]byte{}, k.publicKey...), indexBytes(index)...)
+	for {
+		sum, err := hmacSHA512(k.chainCode, data)
+		if err != nil {
+			return nil, err
+		}
+		pubKey, valid, err := k.curve.childPublicKey(k.publicKey, sum[0:32])
+		if err != nil {
+			return nil, err
+		}
+		if valid {
+			return &NeuteredKey{
+				curve:     k.curve,
+				publicKey: pubKey,
+				chainCode: sum[32:64],
+			}, nil
+		}
+		// Invalid key; retry with the right half of the result.
+		data = append(append([]byte{0x01}, sum[32:64]...), indexBytes(index)...)
+	}
+}
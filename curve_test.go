@@ -0,0 +1,458 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveCurveKey(t *testing.T) {
+	type test struct {
+		name      string
+		curve     Curve
+		seed      []byte
+		path      string
+		err       string
+		chainCode []byte
+		key       []byte
+		pubKey    []byte
+	}
+
+	// Vectors are from SLIP-0010; the secp256k1 vectors are those of BIP-32.
+	seed1 := _strToHex("000102030405060708090a0b0c0d0e0f")
+	seed2 := _strToHex("fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542")
+
+	tests := []test{
+		{
+			name:  "Ed25519Unhardened",
+			curve: Ed25519,
+			seed:  seed1,
+			path:  "m/0'/1",
+			err:   "elements must be hardened",
+		},
+		{
+			name:  "InvalidPath",
+			curve: Secp256k1,
+			seed:  seed1,
+			path:  "m/a",
+			err:   "invalid path",
+		},
+		{
+			name:      "Secp256k1Vector1Master",
+			curve:     Secp256k1,
+			seed:      seed1,
+			path:      "m",
+			chainCode: _strToHex("873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508"),
+			key:       _strToHex("e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"),
+			pubKey:    _strToHex("0339a36013301597daef41fbe593a02cc513d0b55527ec2df1050e2e8ff49c85c2"),
+		},
+		{
+			name:      "Secp256k1Vector1Depth1",
+			curve:     Secp256k1,
+			seed:      seed1,
+			path:      "m/0'",
+			chainCode: _strToHex("47fdacbd0f1097043b78c63c20c34ef4ed9a111d980047ad16282c7ae6236141"),
+			key:       _strToHex("edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"),
+			pubKey:    _strToHex("035a784662a4a20a65bf6aab9ae98a6c068a81c52e4b032c0fb5400c706cfccc56"),
+		},
+		{
+			name:      "Secp256k1Vector1Depth2",
+			curve:     Secp256k1,
+			seed:      seed1,
+			path:      "m/0'/1",
+			chainCode: _strToHex("2a7857631386ba23dacac34180dd1983734e444fdbf774041578e9b6adb37c19"),
+			key:       _strToHex("3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368"),
+			pubKey:    _strToHex("03501e454bf00751f24b1b489aa925215d66af2234e3891c3b21a52bedb3cd711c"),
+		},
+		{
+			name:      "Secp256k1Vector1Depth3",
+			curve:     Secp256k1,
+			seed:      seed1,
+			path:      "m/0'/1/2'",
+			chainCode: _strToHex("04466b9cc8e161e966409ca52986c584f07e9dc81f735db683c3ff6ec7b1503f"),
+			key:       _strToHex("cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca"),
+			pubKey:    _strToHex("0357bfe1e341d01c69fe5654309956cbea516822fba8a601743a012a7896ee8dc2"),
+		},
+		{
+			name:      "Secp256k1Vector1Depth4",
+			curve:     Secp256k1,
+			seed:      seed1,
+			path:      "m/0'/1/2'/2",
+			chainCode: _strToHex("cfb71883f01676f587d023cc53a35bc7f88f724b1f8c2892ac1275ac822a3edd"),
+			key:       _strToHex("0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4"),
+			pubKey:    _strToHex("02e8445082a72f29b75ca48748a914df60622a609cacfce8ed0e35804560741d29"),
+		},
+		{
+			name:      "Secp256k1Vector1Depth5",
+			curve:     Secp256k1,
+			seed:      seed1,
+			path:      "m/0'/1/2'/2/1000000000",
+			chainCode: _strToHex("c783e67b921d2beb8f6b389cc646d7263b4145701dadd2161548a8b078e65e9e"),
+			key:       _strToHex("471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8"),
+			pubKey:    _strToHex("022a471424da5e657499d1ff51cb43c47481a03b1e77f951fe64cec9f5a48f7011"),
+		},
+		{
+			name:      "Secp256k1Vector2Master",
+			curve:     Secp256k1,
+			seed:      seed2,
+			path:      "m",
+			chainCode: _strToHex("60499f801b896d83179a4374aeb7822aaeaceaa0db1f85ee3e904c4defbd9689"),
+			key:       _strToHex("4b03d6fc340455b363f51020ad3ecca4f0850280cf436c70c727923f6db46c3e"),
+			pubKey:    _strToHex("03cbcaa9c98c877a26977d00825c956a238e8dddfbd322cce4f74b0b5bd6ace4a7"),
+		},
+		{
+			name:      "Secp256k1Vector2Depth1",
+			curve:     Secp256k1,
+			seed:      seed2,
+			path:      "m/0",
+			chainCode: _strToHex("f0909affaa7ee7abe5dd4e100598d4dc53cd709d5a5c2cac40e7412f232f7c9c"),
+			key:       _strToHex("abe74a98f6c7eabee0428f53798f0ab8aa1bd37873999041703c742f15ac7e1e"),
+			pubKey:    _strToHex("02fc9e5af0ac8d9b3cecfe2a888e2117ba3d089d8585886c9c826b6b22a98d12ea"),
+		},
+		{
+			name:      "Secp256k1Vector2Depth2",
+			curve:     Secp256k1,
+			seed:      seed2,
+			path:      "m/0/2147483647'",
+			chainCode: _strToHex("be17a268474a6bb9c61e1d720cf6215e2a88c5406c4aee7b38547f585c9a37d9"),
+			key:       _strToHex("877c779ad9687164e9c2f4f0f4ff0340814392330693ce95a58fe18fd52e6e93"),
+			pubKey:    _strToHex("03c01e7425647bdefa82b12d9bad5e3e6865bee0502694b94ca58b666abc0a5c3b"),
+		},
+		{
+			name:      "Secp256k1Vector2Depth3",
+			curve:     Secp256k1,
+			seed:      seed2,
+			path:      "m/0/2147483647'/1",
+			chainCode: _strToHex("f366f48f1ea9f2d1d3fe958c95ca84ea18e4c4ddb9366c336c927eb246fb38cb"),
+			key:       _strToHex("704addf544a06e5ee4bea37098463c23613da32020d604506da8c0518e1da4b7"),
+			pubKey:    _strToHex("03a7d1d856deb74c508e05031f9895dab54626251b3806e16b4bd12e781a7df5b9"),
+		},
+		{
+			name:      "Secp256k1Vector2Depth4",
+			curve:     Secp256k1,
+			seed:      seed2,
+			path:      "m/0/2147483647'/1/2147483646'",
+			chainCode: _strToHex("637807030d55d01f9a0cb3a7839515d796bd07706386a6eddf06cc29a65a0e29"),
+			key:       _strToHex("f1c7c871a54a804afe328b4c83a1c33b8e5ff48f5087273f04efa83b247d6a2d"),
+			pubKey:    _strToHex("02d2b36900396c9282fa14628566582f206a5dd0bcc8d5e892611806cafb0301f0"),
+		},
+		{
+			name:      "Secp256k1Vector2Depth5",
+			curve:     Secp256k1,
+			seed:      seed2,
+			path:      "m/0/2147483647'/1/2147483646'/2",
+			chainCode: _strToHex("9452b549be8cea3ecb7a84bec10dcfd94afe4d129ebfd3b3cb58eedf394ed271"),
+			key:       _strToHex("bb7d39bdb83ecf58f2fd82b6d918341cbef428661ef01ab97c28a4842125ac23"),
+			pubKey:    _strToHex("024d902e1a2fc7a8755ab5b694c575fce742c48d9ff192e63df5193e4c7afe1f9c"),
+		},
+		{
+			name:      "NIST256p1Vector1Master",
+			curve:     NIST256p1,
+			seed:      seed1,
+			path:      "m",
+			chainCode: _strToHex("beeb672fe4621673f722f38529c07392fecaa61015c80c34f29ce8b41b3cb6ea"),
+			key:       _strToHex("612091aaa12e22dd2abef664f8a01a82cae99ad7441b7ef8110424915c268bc2"),
+			pubKey:    _strToHex("0266874dc6ade47b3ecd096745ca09bcd29638dd52c2c12117b11ed3e458cfa9e8"),
+		},
+		{
+			name:      "NIST256p1Vector1Depth1",
+			curve:     NIST256p1,
+			seed:      seed1,
+			path:      "m/0'",
+			chainCode: _strToHex("3460cea53e6a6bb5fb391eeef3237ffd8724bf0a40e94943c98b83825342ee11"),
+			key:       _strToHex("6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c"),
+			pubKey:    _strToHex("0384610f5ecffe8fda089363a41f56a5c7ffc1d81b59a612d0d649b2d22355590c"),
+		},
+		{
+			name:      "NIST256p1Vector1Depth2",
+			curve:     NIST256p1,
+			seed:      seed1,
+			path:      "m/0'/1",
+			chainCode: _strToHex("4187afff1aafa8445010097fb99d23aee9f599450c7bd140b6826ac22ba21d0c"),
+			key:       _strToHex("284e9d38d07d21e4e281b645089a94f4cf5a5a81369acf151a1c3a57f18b2129"),
+			pubKey:    _strToHex("03526c63f8d0b4bbbf9c80df553fe66742df4676b241dabefdef67733e070f6844"),
+		},
+		{
+			name:      "NIST256p1Vector1Depth3",
+			curve:     NIST256p1,
+			seed:      seed1,
+			path:      "m/0'/1/2'",
+			chainCode: _strToHex("98c7514f562e64e74170cc3cf304ee1ce54d6b6da4f880f313e8204c2a185318"),
+			key:       _strToHex("694596e8a54f252c960eb771a3c41e7e32496d03b954aeb90f61635b8e092aa7"),
+			pubKey:    _strToHex("0359cf160040778a4b14c5f4d7b76e327ccc8c4a6086dd9451b7482b5a4972dda0"),
+		},
+		{
+			name:      "NIST256p1Vector1Depth4",
+			curve:     NIST256p1,
+			seed:      seed1,
+			path:      "m/0'/1/2'/2",
+			chainCode: _strToHex("ba96f776a5c3907d7fd48bde5620ee374d4acfd540378476019eab70790c63a0"),
+			key:       _strToHex("5996c37fd3dd2679039b23ed6f70b506c6b56b3cb5e424681fb0fa64caf82aaa"),
+			pubKey:    _strToHex("029f871f4cb9e1c97f9f4de9ccd0d4a2f2a171110c61178f84430062230833ff20"),
+		},
+		{
+			name:      "NIST256p1Vector1Depth5",
+			curve:     NIST256p1,
+			seed:      seed1,
+			path:      "m/0'/1/2'/2/1000000000",
+			chainCode: _strToHex("b9b7b82d326bb9cb5b5b121066feea4eb93d5241103c9e7a18aad40f1dde8059"),
+			key:       _strToHex("21c4f269ef0a5fd1badf47eeacebeeaa3de22eb8e5b0adcd0f27dd99d34d0119"),
+			pubKey:    _strToHex("02216cd26d31147f72427a453c443ed2cde8a1e53c9cc44e5ddf739725413fe3f4"),
+		},
+		{
+			name:      "NIST256p1Vector2Master",
+			curve:     NIST256p1,
+			seed:      seed2,
+			path:      "m",
+			chainCode: _strToHex("96cd4465a9644e31528eda3592aa35eb39a9527769ce1855beafc1b81055e75d"),
+			key:       _strToHex("eaa31c2e46ca2962227cf21d73a7ef0ce8b31c756897521eb6c7b39796633357"),
+			pubKey:    _strToHex("02c9e16154474b3ed5b38218bb0463e008f89ee03e62d22fdcc8014beab25b48fa"),
+		},
+		{
+			name:      "NIST256p1Vector2Depth1",
+			curve:     NIST256p1,
+			seed:      seed2,
+			path:      "m/0",
+			chainCode: _strToHex("84e9c258bb8557a40e0d041115b376dd55eda99c0042ce29e81ebe4efed9b86a"),
+			key:       _strToHex("d7d065f63a62624888500cdb4f88b6d59c2927fee9e6d0cdff9cad555884df6e"),
+			pubKey:    _strToHex("039b6df4bece7b6c81e2adfeea4bcf5c8c8a6e40ea7ffa3cf6e8494c61a1fc82cc"),
+		},
+		{
+			name:      "NIST256p1Vector2Depth2",
+			curve:     NIST256p1,
+			seed:      seed2,
+			path:      "m/0/2147483647'",
+			chainCode: _strToHex("f235b2bc5c04606ca9c30027a84f353acf4e4683edbd11f635d0dcc1cd106ea6"),
+			key:       _strToHex("96d2ec9316746a75e7793684ed01e3d51194d81a42a3276858a5b7376d4b94b9"),
+			pubKey:    _strToHex("02f89c5deb1cae4fedc9905f98ae6cbf6cbab120d8cb85d5bd9a91a72f4c068c76"),
+		},
+		{
+			name:      "NIST256p1Vector2Depth3",
+			curve:     NIST256p1,
+			seed:      seed2,
+			path:      "m/0/2147483647'/1",
+			chainCode: _strToHex("7c0b833106235e452eba79d2bdd58d4086e663bc8cc55e9773d2b5eeda313f3b"),
+			key:       _strToHex("974f9096ea6873a915910e82b29d7c338542ccde39d2064d1cc228f371542bbc"),
+			pubKey:    _strToHex("03abe0ad54c97c1d654c1852dfdc32d6d3e487e75fa16f0fd6304b9ceae4220c64"),
+		},
+		{
+			name:      "NIST256p1Vector2Depth4",
+			curve:     NIST256p1,
+			seed:      seed2,
+			path:      "m/0/2147483647'/1/2147483646'",
+			chainCode: _strToHex("5794e616eadaf33413aa309318a26ee0fd5163b70466de7a4512fd4b1a5c9e6a"),
+			key:       _strToHex("da29649bbfaff095cd43819eda9a7be74236539a29094cd8336b07ed8d4eff63"),
+			pubKey:    _strToHex("03cb8cb067d248691808cd6b5a5a06b48e34ebac4d965cba33e6dc46fe13d9b933"),
+		},
+		{
+			name:      "NIST256p1Vector2Depth5",
+			curve:     NIST256p1,
+			seed:      seed2,
+			path:      "m/0/2147483647'/1/2147483646'/2",
+			chainCode: _strToHex("3bfb29ee8ac4484f09db09c2079b520ea5616df7820f071a20320366fbe226a7"),
+			key:       _strToHex("bb0a77ba01cc31d77205d51d08bd313b979a71ef4de9b062f8958297e746bd67"),
+			pubKey:    _strToHex("020ee02e18967237cf62672983b253ee62fa4dd431f8243bfeccdf39dbe181387f"),
+		},
+		{
+			name:      "Curve25519Vector1Master",
+			curve:     Curve25519,
+			seed:      seed1,
+			path:      "m",
+			chainCode: _strToHex("77997ca3588a1a34f3589279ea2962247abfe5277d52770a44c706378c710768"),
+			key:       _strToHex("d70a59c2e68b836cc4bbe8bcae425169b9e2384f3905091e3d60b890e90cd92c"),
+			pubKey:    _strToHex("005c7289dc9f7f3ea1c8c2de7323b9fb0781f69c9ecd6de4f095ac89a02dc80577"),
+		},
+		{
+			name:      "Curve25519Vector1Depth1",
+			curve:     Curve25519,
+			seed:      seed1,
+			path:      "m/0'",
+			chainCode: _strToHex("349a3973aad771c628bf1f1b4d5e071f18eff2e492e4aa7972a7e43895d6597f"),
+			key:       _strToHex("cd7630d7513cbe80515f7317cdb9a47ad4a56b63c3f1dc29583ab8d4cc25a9b2"),
+			pubKey:    _strToHex("00cb8be6b256ce509008b43ae0dccd69960ad4f7ff2e2868c1fbc9e19ec3ad544b"),
+		},
+		{
+			name:      "Curve25519Vector1Depth2",
+			curve:     Curve25519,
+			seed:      seed1,
+			path:      "m/0'/1'",
+			chainCode: _strToHex("2ee5ba14faf2fe9d7ab532451c2be3a0a5375c5e8c44fb31d9ad7edc25cda000"),
+			key:       _strToHex("a95f97cfc1a61dd833b882c89d36a78a030ea6b2fbe3ae2a70e4f1fc9008d6b1"),
+			pubKey:    _strToHex("00e9506455dce2526df42e5e4eb5585eaef712e5f9c6a28bf9fb175d96595ea872"),
+		},
+		{
+			name:      "Curve25519Vector1Depth3",
+			curve:     Curve25519,
+			seed:      seed1,
+			path:      "m/0'/1'/2'",
+			chainCode: _strToHex("e1897d5a96459ce2a3d294cb2a6a59050ee61255818c50e03ac4263ef17af084"),
+			key:       _strToHex("3d6cce04a9175929da907a90b02176077b9ae050dcef9b959fed978bb2200cdc"),
+			pubKey:    _strToHex("0018f008fcbc6d1cd8b4fe7a9eba00f6570a9da02a9b0005028cb2731b12ee4118"),
+		},
+		{
+			name:      "Curve25519Vector1Depth4",
+			curve:     Curve25519,
+			seed:      seed1,
+			path:      "m/0'/1'/2'/2'",
+			chainCode: _strToHex("1cccc84e2737cfe81b51fbe4c97bbdb000f6a76eddffb9ed03108fbff3ff7e4f"),
+			key:       _strToHex("7ae7437efe0a3018999e6f00d72e810ebc50578dbf6728bfa1c7fe73501081a7"),
+			pubKey:    _strToHex("00512e288a8ef4d869620dc4b06bb06ad2524b350dee5a39fcfeb708dbac65c25c"),
+		},
+		{
+			name:      "Curve25519Vector1Depth5",
+			curve:     Curve25519,
+			seed:      seed1,
+			path:      "m/0'/1'/2'/2'/1000000000'",
+			chainCode: _strToHex("8ccf15d55b1dda246b0c1bf3e979a471a82524c1bd0c1eaecccf00dde72168bb"),
+			key:       _strToHex("7a59954d387abde3bc703f531f67d659ec2b8a12597ae82824547d7e27991e26"),
+			pubKey:    _strToHex("00a077fcf5af53d210257d44a86eb2031233ac7237da220434ac01a0bebccc1919"),
+		},
+		{
+			name:      "Curve25519Vector2Master",
+			curve:     Curve25519,
+			seed:      seed2,
+			path:      "m",
+			chainCode: _strToHex("b62c0c81a80a0ee16b977abb3677eb47549d0eef090f7a6c2b2010e739875e34"),
+			key:       _strToHex("088491f5b4dfafbe956de471f3db10e02d784bc76050ee3b7c3f11b9706d3730"),
+			pubKey:    _strToHex("0060cc3b40567729af08757e1efe62536dc864a57ec582f98b96f484201a260c7a"),
+		},
+		{
+			name:      "Curve25519Vector2Depth1",
+			curve:     Curve25519,
+			seed:      seed2,
+			path:      "m/0'",
+			chainCode: _strToHex("341f386e571229e8adc52b82e824532817a31a35ba49ae334424e7228d020eed"),
+			key:       _strToHex("8e73218a1ba5c7b95e94b6e7cf7b37fb6240fb3b2ecd801402a4439da7067ee2"),
+			pubKey:    _strToHex("007992b3f270ef15f266785fffb73246ad7f40d1fe8679b737fed0970d92cc5f39"),
+		},
+		{
+			name:      "Curve25519Vector2Depth2",
+			curve:     Curve25519,
+			seed:      seed2,
+			path:      "m/0'/2147483647'",
+			chainCode: _strToHex("942cbec088b4ae92e8db9336025e9185fec0985a3da89d7a408bc2a4e18a8134"),
+			key:       _strToHex("29262b215c961bae20274588b33955c36f265c1f626df9feebb51034ce63c19d"),
+			pubKey:    _strToHex("002372feac417c38b833e1aba75f2420278122d698605b995cafc2fed7bb453d41"),
+		},
+		{
+			name:      "Curve25519Vector2Depth3",
+			curve:     Curve25519,
+			seed:      seed2,
+			path:      "m/0'/2147483647'/1'",
+			chainCode: _strToHex("fe02397ae2ca71efe455f470fb23928baf026360a9e9090e21958f6fba9efc30"),
+			key:       _strToHex("a4d2474bd98c5e9ff416f536697b89949627d6d2c384b81a86d29f1136f4c2d1"),
+			pubKey:    _strToHex("00eca4fd0458d3f729b6218eda871b350fa8870a744caf6d30cd84dad2b9dd9c2d"),
+		},
+		{
+			name:      "Curve25519Vector2Depth4",
+			curve:     Curve25519,
+			seed:      seed2,
+			path:      "m/0'/2147483647'/1'/2147483646'",
+			chainCode: _strToHex("b3b49d550e732ee629f4aeb4bf7213c3ae0f239fd10add513253cddbb8efb868"),
+			key:       _strToHex("d3500d9b30529c51d92497eded1d68d29f60c630c45c61a481c185e574c6e5cf"),
+			pubKey:    _strToHex("00edaa3d381a2b02f40a80d69b2ce7ba7c3c4a9421744808857cd48c50d29b5868"),
+		},
+		{
+			name:      "Curve25519Vector2Depth5",
+			curve:     Curve25519,
+			seed:      seed2,
+			path:      "m/0'/2147483647'/1'/2147483646'/2'",
+			chainCode: _strToHex("f6ded904046e9758b9388dbf95ea5db837ab98b03b00e4db7009a8e3ac077685"),
+			key:       _strToHex("e20fecd59312b63b37eee27714465aae1caa1c87840abd0d685ea88b3d598fdf"),
+			pubKey:    _strToHex("00aa705de68066e9534a238af35ea77c48016462a8aff358d22eaa6c7d5b034354"),
+		},
+		{
+			name:      "NIST256p1ChildRetry",
+			curve:     NIST256p1,
+			seed:      seed1,
+			path:      "m/28578'/33941",
+			chainCode: _strToHex("9e87fe95031f14736774cd82f25fd885065cb7c358c1edf813c72af535e83071"),
+			key:       _strToHex("092154eed4af83e078ff9b84322015aefe5769e31270f62c3f66c33888335f3a"),
+		},
+		{
+			name:      "NIST256p1MasterRetry",
+			curve:     NIST256p1,
+			seed:      _strToHex("a7305bc8df8d0951f0cb224c0e95d7707cbdf2c6ce7e8d481fec69c7ff5e9446"),
+			path:      "m",
+			chainCode: _strToHex("7762f9729fed06121fd13f326884c82f59aa95c57ac492ce8c9654e60efd130c"),
+			key:       _strToHex("3b8c18469a4634517d6d0b65448f8e6c62091b45540a1743c5846be55d47d88f"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := DeriveCurveKey(test.curve, test.seed, test.path)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.curve, key.Curve())
+			require.Equal(t, test.chainCode, key.ChainCode())
+			require.Equal(t, test.key, key.key)
+			if test.pubKey != nil {
+				pubKey, err := key.PublicKey()
+				require.NoError(t, err)
+				if test.curve == Curve25519 {
+					// SLIP-0010 serialises Curve25519 public keys with a leading 0x00 byte.
+					pubKey = append([]byte{0x00}, pubKey...)
+				}
+				require.Equal(t, test.pubKey, pubKey)
+			}
+		})
+	}
+}
+
+func TestDeriveCurveKeyEd25519(t *testing.T) {
+	seed := _strToHex("000102030405060708090a0b0c0d0e0f")
+
+	key, err := DeriveCurveKey(Ed25519, seed, "m/0'/1'/2'")
+	require.NoError(t, err)
+	expected, err := DeriveKey(seed, "m/0'/1'/2'")
+	require.NoError(t, err)
+	require.Equal(t, expected.key, key.key)
+	require.Equal(t, expected.chainCode, key.chainCode)
+}
+
+func TestNeuteredKey(t *testing.T) {
+	seed := _strToHex("000102030405060708090a0b0c0d0e0f")
+
+	edKey, err := MasterKeyFromSeed(seed)
+	require.NoError(t, err)
+	_, err = edKey.Neuter()
+	require.EqualError(t, err, "curve does not support public derivation")
+
+	for _, curve := range []Curve{Secp256k1, NIST256p1} {
+		t.Run(curve.Name(), func(t *testing.T) {
+			parent, err := DeriveCurveKey(curve, seed, "m/0'/1/2'")
+			require.NoError(t, err)
+			neutered, err := parent.Neuter()
+			require.NoError(t, err)
+
+			_, err = neutered.Child(hardenedOffset)
+			require.EqualError(t, err, "cannot derive hardened child from public key")
+
+			child, err := neutered.Child(2)
+			require.NoError(t, err)
+			expected, err := DeriveCurveKey(curve, seed, "m/0'/1/2'/2")
+			require.NoError(t, err)
+			expectedPubKey, err := expected.PublicKey()
+			require.NoError(t, err)
+			require.Equal(t, expectedPubKey, child.PublicKey())
+			require.Equal(t, expected.ChainCode(), child.ChainCode())
+		})
+	}
+}
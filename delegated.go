@@ -0,0 +1,110 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrOutsideSubtree is returned when a path is not beneath a delegated key.
+	ErrOutsideSubtree = errors.New("path is outside the delegated subtree")
+	// ErrParentDerivation is returned when a path is above a delegated key.
+	ErrParentDerivation = errors.New("cannot derive parent of delegated key")
+)
+
+// DelegatedKey is a key that can only derive keys beneath its own path.
+// It does not hold the seed, so cannot derive keys in the rest of the tree.
+type DelegatedKey struct {
+	key      *Key
+	elements []uint32
+}
+
+// NewDelegatedKey derives the key at the given path and restricts it to
+// deriving keys beneath that path.
+func NewDelegatedKey(seed []byte, path string) (*DelegatedKey, error) {
+	elements, err := validElementsForPath(path)
+	if err != nil {
+		return nil, err
+	}
+	master, err := MasterKeyFromSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+	key, err := deriveKeyElements(master, elements)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DelegatedKey{
+		key:      key,
+		elements: elements,
+	}, nil
+}
+
+// Path returns the absolute path of the delegated key.
+func (d *DelegatedKey) Path() string {
+	return pathForElements(d.elements)
+}
+
+// PublicKey returns the public key of the delegated key.
+func (d *DelegatedKey) PublicKey() ([]byte, error) {
+	return d.key.PublicKey()
+}
+
+// DeriveKey derives the key at an absolute path, which must be the path of
+// the delegated key or beneath it.
+func (d *DelegatedKey) DeriveKey(path string) (*Key, error) {
+	elements, err := d.relativeElements(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return deriveKeyElements(d.key, elements)
+}
+
+// Delegate returns a new delegated key at an absolute path, which must be
+// beneath the path of this delegated key.
+func (d *DelegatedKey) Delegate(path string) (*DelegatedKey, error) {
+	elements, err := d.relativeElements(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := deriveKeyElements(d.key, elements)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DelegatedKey{
+		key:      key,
+		elements: append(append([]uint32{}, d.elements...), elements...),
+	}, nil
+}
+
+// relativeElements returns the elements of an absolute path relative to the
+// delegated key.
+func (d *DelegatedKey) relativeElements(path string) ([]uint32, error) {
+	elements, err := validElementsForPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if !elementsHavePrefix(elements, d.elements) {
+		if elementsHavePrefix(d.elements, elements) {
+			return nil, ErrParentDerivation
+		}
+		return nil, errors.Wrap(ErrOutsideSubtree, path)
+	}
+
+	return elements[len(d.elements):], nil
+}
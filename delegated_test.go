@@ -0,0 +1,118 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDelegatedKey(t *testing.T) {
+	seed := _strToHex("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000")
+
+	_, err := NewDelegatedKey(seed, "m/44")
+	require.EqualError(t, err, "invalid path")
+	_, err = NewDelegatedKey(nil, "m/44'/501'/7'")
+	require.EqualError(t, err, "seed must be between 16 and 64 bytes (passed 0)")
+
+	delegated, err := NewDelegatedKey(seed, "m/44'/solana'/7'")
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/501'/7'", delegated.Path())
+
+	expected, err := DeriveKey(seed, "m/44'/501'/7'")
+	require.NoError(t, err)
+	expectedPubKey, err := expected.PublicKey()
+	require.NoError(t, err)
+	pubKey, err := delegated.PublicKey()
+	require.NoError(t, err)
+	require.Equal(t, expectedPubKey, pubKey)
+}
+
+func TestDelegatedKeyDeriveKey(t *testing.T) {
+	seed := _strToHex("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000")
+	delegated, err := NewDelegatedKey(seed, "m/44'/501'/7'")
+	require.NoError(t, err)
+
+	type test struct {
+		name string
+		path string
+		err  string
+	}
+
+	tests := []test{
+		{
+			name: "Invalid",
+			path: "m/44'/501'/7'/0'",
+			err:  "invalid path",
+		},
+		{
+			name: "Parent",
+			path: "m/44'/501'",
+			err:  "cannot derive parent of delegated key",
+		},
+		{
+			name: "Sibling",
+			path: "m/44'/501'/8'/0",
+			err:  "m/44'/501'/8'/0: path is outside the delegated subtree",
+		},
+		{
+			name: "Self",
+			path: "m/44'/501'/7'",
+		},
+		{
+			name: "Child",
+			path: "m/44'/501'/7'/0",
+		},
+		{
+			name: "Grandchild",
+			path: "m/44'/501'/7'/0/3",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := delegated.DeriveKey(test.path)
+			if test.err == "" {
+				require.NoError(t, err)
+				expected, err := DeriveKey(seed, test.path)
+				require.NoError(t, err)
+				require.Equal(t, expected, key)
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestDelegatedKeyDelegate(t *testing.T) {
+	seed := _strToHex("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000")
+	delegated, err := NewDelegatedKey(seed, "m/44'/501'/7'")
+	require.NoError(t, err)
+
+	child, err := delegated.Delegate("m/44'/501'/7'/1")
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/501'/7'/1", child.Path())
+
+	key, err := child.DeriveKey("m/44'/501'/7'/1/2")
+	require.NoError(t, err)
+	expected, err := DeriveKey(seed, "m/44'/501'/7'/1/2")
+	require.NoError(t, err)
+	require.Equal(t, expected, key)
+
+	_, err = child.DeriveKey("m/44'/501'/7'/0/2")
+	require.EqualError(t, err, "m/44'/501'/7'/0/2: path is outside the delegated subtree")
+	_, err = child.Delegate("m/44'/501'/7'")
+	require.EqualError(t, err, "cannot derive parent of delegated key")
+}
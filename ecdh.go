@@ -0,0 +1,63 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/curve25519"
+)
+
+// ecdhPurpose is the purpose used by SLIP-0017 ECDH paths.
+const ecdhPurpose = uint32(17)
+
+// ErrSharedSecretCurve is returned when a shared secret is requested from a key on an unsupported curve.
+var ErrSharedSecretCurve = errors.New("shared secret requires a curve25519 key")
+
+// ECDHPath returns the SLIP-0017 derivation path for an identity URI and an
+// index.  All elements are hardened, in the form accepted by DeriveCurveKey.
+// https://github.com/satoshilabs/slips/blob/master/slip-0017.md
+func ECDHPath(uri string, index uint32) string {
+	elements := identityElements(uri, index)
+
+	return fmt.Sprintf("m/%d'/%d'/%d'/%d'/%d'", ecdhPurpose, elements[0], elements[1], elements[2], elements[3])
+}
+
+// DeriveECDHKey derives the SLIP-0017 curve25519 key for an identity URI and an index.
+func DeriveECDHKey(seed []byte, uri string, index uint32) (*Key, error) {
+	return DeriveCurveKey(Curve25519, seed, ECDHPath(uri, index))
+}
+
+// SharedSecret returns the X25519 shared secret between the key and a peer's
+// public key.  The peer's public key can be 32 bytes, or 33 bytes with a 0x00
+// prefix as defined by SLIP-0010.
+func (k *Key) SharedSecret(peerPublicKey []byte) ([]byte, error) {
+	if k.Curve() != Curve25519 {
+		return nil, ErrSharedSecretCurve
+	}
+	if len(peerPublicKey) == 33 && peerPublicKey[0] == 0x00 {
+		peerPublicKey = peerPublicKey[1:]
+	}
+	if len(peerPublicKey) != 32 {
+		return nil, fmt.Errorf("peer public key must be 32 bytes (passed %d)", len(peerPublicKey))
+	}
+
+	secret, err := curve25519.X25519(k.key, peerPublicKey)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidPublicKey, err.Error())
+	}
+
+	return secret, nil
+}
@@ -0,0 +1,62 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestECDHPath(t *testing.T) {
+	// Elements are as for SLIP-0013, but with purpose 17 and all hardened.
+	require.Equal(t, "m/17'/490267344'/697598796'/1613620211'/1858012177'", ECDHPath("https://satoshi@bitcoin.org/login", 0))
+}
+
+func TestSharedSecret(t *testing.T) {
+	seed := _strToHex("000102030405060708090a0b0c0d0e0f")
+
+	alice, err := DeriveECDHKey(seed, "ssh://alice@example.com", 0)
+	require.NoError(t, err)
+	alicePubKey, err := alice.PublicKey()
+	require.NoError(t, err)
+	bob, err := DeriveECDHKey(seed, "ssh://bob@example.com", 0)
+	require.NoError(t, err)
+	bobPubKey, err := bob.PublicKey()
+	require.NoError(t, err)
+	require.NotEqual(t, alicePubKey, bobPubKey)
+
+	aliceSecret, err := alice.SharedSecret(bobPubKey)
+	require.NoError(t, err)
+	bobSecret, err := bob.SharedSecret(alicePubKey)
+	require.NoError(t, err)
+	require.Equal(t, aliceSecret, bobSecret)
+
+	// SLIP-0010 serialisation of the public key.
+	prefixedSecret, err := bob.SharedSecret(append([]byte{0x00}, alicePubKey...))
+	require.NoError(t, err)
+	require.Equal(t, aliceSecret, prefixedSecret)
+
+	_, err = alice.SharedSecret(bobPubKey[1:])
+	require.EqualError(t, err, "peer public key must be 32 bytes (passed 31)")
+
+	// Low-order point.
+	_, err = alice.SharedSecret(make([]byte, 32))
+	require.Error(t, err)
+
+	edKey, err := DeriveKey(seed, "m/0'")
+	require.NoError(t, err)
+	_, err = edKey.SharedSecret(bobPubKey)
+	require.EqualError(t, err, "shared secret requires a curve25519 key")
+}
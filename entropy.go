@@ -0,0 +1,95 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// EntropySource is a physical source of entropy, such as a die.
+type EntropySource struct {
+	// Name is the name of the source.
+	Name string
+	// Outcomes is the number of equally likely outcomes of a single event.
+	Outcomes int
+}
+
+var (
+	// D6 is a six-sided die.
+	D6 = &EntropySource{Name: "d6", Outcomes: 6}
+	// D20 is a twenty-sided die.
+	D20 = &EntropySource{Name: "d20", Outcomes: 20}
+	// Coin is a coin flip, with 1 for heads and 2 for tails.
+	Coin = &EntropySource{Name: "coin", Outcomes: 2}
+	// Card is a card drawn from a full 52-card deck, which is returned to the
+	// deck and shuffled before the next draw.
+	Card = &EntropySource{Name: "card", Outcomes: 52}
+)
+
+// MinEvents returns the minimum number of events from the source required to
+// provide 256 bits of entropy.  This is stricter than Coldcard, which accepts
+// 99 rolls of a d6 (255.9 bits) where 100 are required here.
+func (s *EntropySource) MinEvents() int {
+	return int(math.Ceil(256 / math.Log2(float64(s.Outcomes))))
+}
+
+// MnemonicFromEntropySource generates a 24-word mnemonic from events of a
+// physical entropy source.  Events are numbered from 1 to the number of
+// outcomes of the source, for example 1 to 6 for a six-sided die.
+//
+// The events are hashed with SHA-256 to extract unbiased entropy.  Events from
+// sources with fewer than 10 outcomes are hashed as a string of digits, which
+// matches the hashing of the dice entry of Coldcard; other events are
+// comma-separated.
+//
+// If mixRandom is true the extracted entropy is XORed with entropy from
+// crypto/rand, in which case the mnemonic cannot be reproduced from the events.
+func MnemonicFromEntropySource(source *EntropySource, events []int, mixRandom bool) (string, error) {
+	if source == nil || source.Outcomes < 2 {
+		return "", errors.New("entropy source must have at least 2 outcomes")
+	}
+	if len(events) < source.MinEvents() {
+		return "", fmt.Errorf("at least %d %s events required (found %d)", source.MinEvents(), source.Name, len(events))
+	}
+
+	separator := ""
+	if source.Outcomes >= 10 {
+		separator = ","
+	}
+	values := make([]string, len(events))
+	for i, event := range events {
+		if event < 1 || event > source.Outcomes {
+			return "", fmt.Errorf("invalid %s event %d at position %d", source.Name, event, i+1)
+		}
+		values[i] = strconv.Itoa(event)
+	}
+	entropy := sha256.Sum256([]byte(strings.Join(values, separator)))
+
+	if mixRandom {
+		random := make([]byte, len(entropy))
+		if _, err := rand.Read(random); err != nil {
+			return "", errors.Wrap(err, "failed to generate random entropy")
+		}
+		xorBytes(entropy[:], random)
+	}
+
+	return mnemonicFromEntropy(entropy[:])
+}
@@ -0,0 +1,140 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func _events(n int, outcomes int) []int {
+	res := make([]int, n)
+	for i := range res {
+		res[i] = i%outcomes + 1
+	}
+	return res
+}
+
+func TestMinEvents(t *testing.T) {
+	require.Equal(t, 100, D6.MinEvents())
+	require.Equal(t, 60, D20.MinEvents())
+	require.Equal(t, 256, Coin.MinEvents())
+	require.Equal(t, 45, Card.MinEvents())
+}
+
+func TestMnemonicFromEntropySource(t *testing.T) {
+	type test struct {
+		name     string
+		source   *EntropySource
+		events   []int
+		err      string
+		mnemonic string
+	}
+
+	tests := []test{
+		{
+			name: "NilSource",
+			err:  "entropy source must have at least 2 outcomes",
+		},
+		{
+			name:   "TooFewEvents",
+			source: D6,
+			events: _events(99, 6),
+			err:    "at least 100 d6 events required (found 99)",
+		},
+		{
+			name:   "InvalidEvent",
+			source: D6,
+			events: append(_events(100, 6), 7),
+			err:    "invalid d6 event 7 at position 101",
+		},
+		{
+			name:   "ZeroEvent",
+			source: Coin,
+			events: append([]int{0}, _events(256, 2)...),
+			err:    "invalid coin event 0 at position 1",
+		},
+		{
+			name:     "D6",
+			source:   D6,
+			events:   _events(100, 6),
+			mnemonic: "tornado cactus wheel picture target finish home neither trend picture shoulder endless deputy glide open oxygen another ability forum swear side alcohol devote random",
+		},
+		{
+			name:   "D20",
+			source: D20,
+			events: _events(60, 20),
+		},
+		{
+			name:   "Coin",
+			source: Coin,
+			events: _events(256, 2),
+		},
+		{
+			name:   "Card",
+			source: Card,
+			events: _events(45, 52),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mnemonic, err := MnemonicFromEntropySource(test.source, test.events, false)
+			if test.err == "" {
+				require.NoError(t, err)
+				valid, err := ValidateMnemonic(mnemonic)
+				require.NoError(t, err)
+				require.True(t, valid)
+				if test.mnemonic != "" {
+					require.Equal(t, test.mnemonic, mnemonic)
+				}
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestMnemonicFromEntropySourceBoundary(t *testing.T) {
+	// Coldcard accepts 99 d6 rolls, but 256 bits require 100.
+	_, err := MnemonicFromEntropySource(D6, _events(99, 6), false)
+	require.EqualError(t, err, "at least 100 d6 events required (found 99)")
+	_, err = MnemonicFromEntropySource(D6, _events(100, 6), false)
+	require.NoError(t, err)
+
+	for _, source := range []*EntropySource{D20, Coin, Card} {
+		_, err := MnemonicFromEntropySource(source, _events(source.MinEvents()-1, source.Outcomes), false)
+		require.Error(t, err)
+		_, err = MnemonicFromEntropySource(source, _events(source.MinEvents(), source.Outcomes), false)
+		require.NoError(t, err)
+	}
+}
+
+func TestMnemonicFromEntropySourceMixRandom(t *testing.T) {
+	events := _events(100, 6)
+
+	plain, err := MnemonicFromEntropySource(D6, events, false)
+	require.NoError(t, err)
+	mixed1, err := MnemonicFromEntropySource(D6, events, true)
+	require.NoError(t, err)
+	mixed2, err := MnemonicFromEntropySource(D6, events, true)
+	require.NoError(t, err)
+
+	require.NotEqual(t, plain, mixed1)
+	require.NotEqual(t, mixed1, mixed2)
+	valid, err := ValidateMnemonic(mixed1)
+	require.NoError(t, err)
+	require.True(t, valid)
+}
@@ -0,0 +1,58 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+// identityPurpose is the purpose used by SLIP-0013 identity paths.
+const identityPurpose = uint32(13)
+
+// IdentityPath returns the SLIP-0013 derivation path for an identity URI,
+// such as https://satoshi@bitcoin.org/login, and an index.
+// https://github.com/satoshilabs/slips/blob/master/slip-0013.md
+//
+// The path is returned in the form accepted by DeriveKey, which hardens all elements.
+func IdentityPath(uri string, index uint32) string {
+	return identityPath(identityPurpose, uri, index)
+}
+
+// DeriveIdentityKey derives the SLIP-0013 key for an identity URI and an index.
+func DeriveIdentityKey(seed []byte, uri string, index uint32) (*Key, error) {
+	return DeriveKey(seed, IdentityPath(uri, index))
+}
+
+func identityPath(purpose uint32, uri string, index uint32) string {
+	elements := identityElements(uri, index)
+
+	return fmt.Sprintf("m/%d'/%d'/%d'/%d/%d", purpose, elements[0], elements[1], elements[2], elements[3])
+}
+
+// identityElements returns the unhardened path elements for an identity.
+func identityElements(uri string, index uint32) []uint32 {
+	data := make([]byte, 4, 4+len(uri))
+	binary.LittleEndian.PutUint32(data, index)
+	data = append(data, []byte(uri)...)
+	hash := sha256.Sum256(data)
+
+	elements := make([]uint32, 4)
+	for i := range elements {
+		elements[i] = binary.LittleEndian.Uint32(hash[i*4:i*4+4]) &^ hardenedOffset
+	}
+
+	return elements
+}
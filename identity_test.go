@@ -0,0 +1,63 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentityPath(t *testing.T) {
+	type test struct {
+		name  string
+		uri   string
+		index uint32
+		path  string
+	}
+
+	tests := []test{
+		{
+			name: "SLIP0013",
+			uri:  "https://satoshi@bitcoin.org/login",
+			// m/2147483661/2637750992/2845082444/3761103859/4005495825 in SLIP-0013 notation.
+			path: "m/13'/490267344'/697598796'/1613620211/1858012177",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := IdentityPath(test.uri, test.index)
+			require.Equal(t, test.path, path)
+			require.True(t, isValidPath(path))
+		})
+	}
+}
+
+func TestDeriveIdentityKey(t *testing.T) {
+	seed := _strToHex("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000")
+
+	key, err := DeriveIdentityKey(seed, "https://satoshi@bitcoin.org/login", 0)
+	require.NoError(t, err)
+	expected, err := DeriveKey(seed, "m/13'/490267344'/697598796'/1613620211/1858012177")
+	require.NoError(t, err)
+	require.Equal(t, expected, key)
+
+	other, err := DeriveIdentityKey(seed, "https://satoshi@bitcoin.org/login", 1)
+	require.NoError(t, err)
+	require.NotEqual(t, key, other)
+
+	_, err = DeriveIdentityKey(nil, "https://satoshi@bitcoin.org/login", 0)
+	require.EqualError(t, err, "seed must be between 16 and 64 bytes (passed 0)")
+}
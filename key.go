@@ -14,18 +14,20 @@
 package ed25519hd
 
 import (
-	"bytes"
 	"crypto/hmac"
 	"crypto/sha512"
+	"encoding/base64"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
-	"golang.org/x/crypto/ed25519"
 )
 
-// Key is an ED255-19 key.
+// Key is a SLIP-0010 key, by default on the Ed25519 curve.
 type Key struct {
+	curve     Curve
 	key       []byte
 	chainCode []byte
 }
@@ -39,59 +41,152 @@ var (
 	hardenedOffset = uint32(0x80000000)
 )
 
+const (
+	// masterKeySalt is the HMAC key used to generate a master key.
+	masterKeySalt = "ed25519 seed"
+	// minSeedLen is the minimum length of a seed in bytes.
+	minSeedLen = 16
+	// maxSeedLen is the maximum length of a seed in bytes.
+	maxSeedLen = 64
+)
+
 // MasterKeyFromSeed generates a master key given a seed.
-// The seed must be 64 bytes to be valid.
+// The seed must be between 16 and 64 bytes to be valid.
 func MasterKeyFromSeed(seed []byte) (*Key, error) {
-	if len(seed) != 64 {
-		return nil, fmt.Errorf("seed must be 64 bytes (passed %d)", len(seed))
+	return NewMasterKey(Ed25519, seed)
+}
+
+// NewMasterKey generates a master key for the given curve from a seed.
+// The seed must be between 16 and 64 bytes to be valid.
+func NewMasterKey(curve Curve, seed []byte) (*Key, error) {
+	if err := checkSeedLen(seed); err != nil {
+		return nil, err
+	}
+
+	data := seed
+	for {
+		mac := hmac.New(sha512.New, []byte(curve.salt()))
+		_, err := mac.Write(data)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to write seed")
+		}
+		result := mac.Sum(nil)
+		if curve.validKey(result[0:32]) {
+			return &Key{
+				curve:     curve,
+				key:       result[0:32],
+				chainCode: result[32:64],
+			}, nil
+		}
+		// Invalid key; rehash the result.
+		data = result
+	}
+}
+
+// SeedFromHex parses a hex string, with or without a 0x prefix, as a seed.
+func SeedFromHex(input string) ([]byte, error) {
+	seed, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse hex seed")
+	}
+	if err := checkSeedLen(seed); err != nil {
+		return nil, err
 	}
 
-	mac := hmac.New(sha512.New, []byte("ed25519 seed"))
-	_, err := mac.Write(seed)
+	return seed, nil
+}
+
+// SeedFromBase64 parses a standard base64 string as a seed.
+func SeedFromBase64(input string) ([]byte, error) {
+	seed, err := base64.StdEncoding.DecodeString(input)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to write seed")
+		return nil, errors.Wrap(err, "failed to parse base64 seed")
+	}
+	if err := checkSeedLen(seed); err != nil {
+		return nil, err
+	}
+
+	return seed, nil
+}
+
+func checkSeedLen(seed []byte) error {
+	if len(seed) < minSeedLen || len(seed) > maxSeedLen {
+		return fmt.Errorf("seed must be between %d and %d bytes (passed %d)", minSeedLen, maxSeedLen, len(seed))
 	}
-	result := mac.Sum(nil)
 
-	return &Key{
-		key:       result[0:32],
-		chainCode: result[32:64],
-	}, nil
+	return nil
 }
 
 func deriveKey(key *Key, index uint32) (*Key, error) {
-	if index < hardenedOffset {
+	curve := key.Curve()
+	if index < hardenedOffset && curve.hardenedOnly() {
 		return nil, ErrUnhardenedElement
 	}
 
-	iBytes := make([]byte, 4)
-	binary.BigEndian.PutUint32(iBytes, index)
-	data := append([]byte{0x0}, key.key...)
-	data = append(data, iBytes...)
-
-	hmac := hmac.New(sha512.New, key.chainCode)
-	_, err := hmac.Write(data)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to write data")
+	data := childData(key, index)
+	if index < hardenedOffset {
+		pubKey, err := key.PublicKey()
+		if err != nil {
+			return nil, err
+		}
+		data = append(pubKey, indexBytes(index)...)
 	}
-	sum := hmac.Sum(nil)
-	newKey := &Key{
-		key:       sum[0:32],
-		chainCode: sum[32:64],
+
+	for {
+		hmac := hmac.New(sha512.New, key.chainCode)
+		_, err := hmac.Write(data)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to write data")
+		}
+		sum := hmac.Sum(nil)
+		childKey, valid := curve.childKey(key.key, sum[0:32])
+		if valid {
+			return &Key{
+				curve:     curve,
+				key:       childKey,
+				chainCode: sum[32:64],
+			}, nil
+		}
+		// Invalid key; retry with the right half of the result.
+		data = append(append([]byte{0x01}, sum[32:64]...), indexBytes(index)...)
 	}
+}
+
+// childData returns the HMAC input used to derive a hardened child key.
+func childData(key *Key, index uint32) []byte {
+	data := append([]byte{0x0}, key.key...)
 
-	return newKey, nil
+	return append(data, indexBytes(index)...)
+}
+
+// indexBytes returns the big-endian serialisation of a path index.
+func indexBytes(index uint32) []byte {
+	res := make([]byte, 4)
+	binary.BigEndian.PutUint32(res, index)
+
+	return res
 }
 
 // PublicKey returns the public key for a derived private key.
+// Keys on the secp256k1 and nist256p1 curves return compressed 33-byte public keys.
+// Keys on the ed25519 and curve25519 curves return 32-byte public keys, without
+// the leading 0x00 byte that SLIP-0010 uses when serialising them.
 func (k *Key) PublicKey() ([]byte, error) {
-	reader := bytes.NewReader(k.key)
-	pub, _, err := ed25519.GenerateKey(reader)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to generate key")
+	return k.Curve().publicKey(k.key)
+}
+
+// Curve returns the curve of the key.
+func (k *Key) Curve() Curve {
+	if k.curve == nil {
+		return Ed25519
 	}
 
-	return pub[:], nil
+	return k.curve
+}
+
+// ChainCode returns a copy of the chain code of the key.
+func (k *Key) ChainCode() []byte {
+	return append([]byte{}, k.chainCode...)
 }
 
 // Seed returns a copy of the seed for a derived path.
@@ -0,0 +1,208 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"bytes"
+	"encoding/base32"
+	"fmt"
+	"strings"
+
+	"filippo.io/edwards25519"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+)
+
+// ErrInvalidNanoAddress is returned when a Nano address is invalid.
+var ErrInvalidNanoAddress = errors.New("invalid Nano address")
+
+// nanoEncoding is the base32 alphabet used by Nano addresses.
+var nanoEncoding = base32.NewEncoding("13456789abcdefghijkmnopqrstuwxyz").WithPadding(base32.NoPadding)
+
+// NanoKey is a Nano private key, used with Ed25519 in which SHA-512 is
+// replaced by Blake2b-512.
+type NanoKey struct {
+	privateKey []byte
+}
+
+// NanoKeyFromSeed generates the Nano key at an index of a 32-byte Nano seed.
+func NanoKeyFromSeed(seed []byte, index uint32) (*NanoKey, error) {
+	if len(seed) != 32 {
+		return nil, fmt.Errorf("seed must be 32 bytes (passed %d)", len(seed))
+	}
+
+	data := append(append([]byte{}, seed...), indexBytes(index)...)
+	privateKey := blake2b.Sum256(data)
+
+	return &NanoKey{
+		privateKey: privateKey[:],
+	}, nil
+}
+
+// DeriveNanoKey derives the Nano key for an account from a BIP-39 seed, using
+// the path m/44'/165'/account'.
+func DeriveNanoKey(seed []byte, account uint32) (*NanoKey, error) {
+	key, err := DeriveKey(seed, fmt.Sprintf("m/44'/165'/%d'", account))
+	if err != nil {
+		return nil, err
+	}
+	privateKey := key.Seed()
+
+	return &NanoKey{
+		privateKey: privateKey[:],
+	}, nil
+}
+
+// PrivateKey returns a copy of the 32-byte private key.
+func (k *NanoKey) PrivateKey() []byte {
+	return append([]byte{}, k.privateKey...)
+}
+
+// PublicKey returns the 32-byte public key.
+func (k *NanoKey) PublicKey() []byte {
+	s, _ := k.expand()
+
+	return new(edwards25519.Point).ScalarBaseMult(s).Bytes()
+}
+
+// Address returns the nano_ address of the key.
+func (k *NanoKey) Address() (string, error) {
+	return NanoAddress(k.PublicKey())
+}
+
+// Sign signs a message.
+func (k *NanoKey) Sign(msg []byte) []byte {
+	s, prefix := k.expand()
+	pubKey := new(edwards25519.Point).ScalarBaseMult(s).Bytes()
+
+	r := nanoHashScalar(prefix, msg)
+	R := new(edwards25519.Point).ScalarBaseMult(r).Bytes()
+	h := nanoHashScalar(R, pubKey, msg)
+	S := edwards25519.NewScalar().MultiplyAdd(h, s, r)
+
+	return append(R, S.Bytes()...)
+}
+
+// expand returns the clamped secret scalar and the nonce prefix of the key.
+func (k *NanoKey) expand() (*edwards25519.Scalar, []byte) {
+	h := blake2b.Sum512(k.privateKey)
+	// Cannot fail, as the input is 32 bytes.
+	s, _ := edwards25519.NewScalar().SetBytesWithClamping(h[:32])
+
+	return s, h[32:]
+}
+
+// VerifyNano verifies a Nano signature over a message.
+func VerifyNano(pubKey []byte, msg []byte, sig []byte) error {
+	if len(pubKey) != 32 {
+		return fmt.Errorf("public key must be 32 bytes (passed %d)", len(pubKey))
+	}
+	if len(sig) != 64 {
+		return fmt.Errorf("signature must be 64 bytes (passed %d)", len(sig))
+	}
+	A, err := new(edwards25519.Point).SetBytes(pubKey)
+	if err != nil {
+		return errors.Wrap(ErrInvalidPublicKey, err.Error())
+	}
+	S, err := edwards25519.NewScalar().SetCanonicalBytes(sig[32:])
+	if err != nil {
+		return errors.Wrap(ErrInvalidSignature, err.Error())
+	}
+
+	// Check that R == [S]B - [h]A.
+	h := nanoHashScalar(sig[:32], pubKey, msg)
+	minusA := new(edwards25519.Point).Negate(A)
+	R := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(h, minusA, S)
+	if !bytes.Equal(R.Bytes(), sig[:32]) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// nanoHashScalar returns the Blake2b-512 hash of the inputs as a scalar.
+func nanoHashScalar(inputs ...[]byte) *edwards25519.Scalar {
+	hash := blake2b.Sum512(bytes.Join(inputs, nil))
+	// Cannot fail, as the input is 64 bytes.
+	s, _ := edwards25519.NewScalar().SetUniformBytes(hash[:])
+
+	return s
+}
+
+// NanoAddress returns the nano_ address for a public key.
+func NanoAddress(pubKey []byte) (string, error) {
+	if len(pubKey) != 32 {
+		return "", fmt.Errorf("public key must be 32 bytes (passed %d)", len(pubKey))
+	}
+	checksum, err := nanoChecksum(pubKey)
+	if err != nil {
+		return "", err
+	}
+	// The 256-bit key is padded to 260 bits; prefixing 3 zero bytes adds
+	// 20 zero bits, encoded as 4 leading characters that are dropped.
+	encodedKey := nanoEncoding.EncodeToString(append([]byte{0x00, 0x00, 0x00}, pubKey...))[4:]
+
+	return "nano_" + encodedKey + nanoEncoding.EncodeToString(checksum), nil
+}
+
+// NanoPublicKeyFromAddress validates a nano_ or xrb_ address and returns its public key.
+func NanoPublicKeyFromAddress(address string) ([]byte, error) {
+	var encoded string
+	switch {
+	case strings.HasPrefix(address, "nano_"):
+		encoded = strings.TrimPrefix(address, "nano_")
+	case strings.HasPrefix(address, "xrb_"):
+		encoded = strings.TrimPrefix(address, "xrb_")
+	default:
+		return nil, errors.Wrap(ErrInvalidNanoAddress, "unknown prefix")
+	}
+	if len(encoded) != 60 {
+		return nil, errors.Wrap(ErrInvalidNanoAddress, "incorrect length")
+	}
+
+	data, err := nanoEncoding.DecodeString("1111" + encoded[:52])
+	if err != nil || !bytes.Equal(data[:3], []byte{0x00, 0x00, 0x00}) {
+		return nil, errors.Wrap(ErrInvalidNanoAddress, "invalid public key")
+	}
+	pubKey := data[3:]
+	expected, err := nanoChecksum(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	checksum, err := nanoEncoding.DecodeString(encoded[52:])
+	if err != nil || !bytes.Equal(checksum, expected) {
+		return nil, errors.Wrap(ErrInvalidNanoAddress, "invalid checksum")
+	}
+
+	return pubKey, nil
+}
+
+// nanoChecksum returns the 5-byte checksum of a public key, which is its
+// Blake2b-40 hash in reverse byte order.
+func nanoChecksum(pubKey []byte) ([]byte, error) {
+	hash, err := blake2b.New(5, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create hash")
+	}
+	_, err = hash.Write(pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write public key")
+	}
+	checksum := hash.Sum(nil)
+	for i, j := 0, len(checksum)-1; i < j; i, j = i+1, j-1 {
+		checksum[i], checksum[j] = checksum[j], checksum[i]
+	}
+
+	return checksum, nil
+}
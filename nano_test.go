@@ -0,0 +1,183 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+)
+
+func TestNanoKeyFromSeed(t *testing.T) {
+	type test struct {
+		name       string
+		seed       []byte
+		index      uint32
+		err        string
+		privateKey []byte
+		publicKey  []byte
+		address    string
+	}
+
+	tests := []test{
+		{
+			name: "SeedShort",
+			seed: _strToHex("0000"),
+			err:  "seed must be 32 bytes (passed 2)",
+		},
+		{
+			name:       "Zero",
+			seed:       make([]byte, 32),
+			privateKey: _strToHex("9f0e444c69f77a49bd0be89db92c38fe713e0963165cca12faf5712d7657120f"),
+			publicKey:  _strToHex("c008b814a7d269a1fa3c6528b19201a24d797912db9996ff02a1ff356e45552b"),
+			address:    "nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := NanoKeyFromSeed(test.seed, test.index)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.privateKey, key.PrivateKey())
+			require.Equal(t, test.publicKey, key.PublicKey())
+			address, err := key.Address()
+			require.NoError(t, err)
+			require.Equal(t, test.address, address)
+		})
+	}
+}
+
+func TestDeriveNanoKey(t *testing.T) {
+	seed, err := SeedFromMnemonic("edge defense waste choose enrich upon flee junk siren film clown finish luggage leader kid quick brick print evidence swap drill paddle truly occur", "some password")
+	require.NoError(t, err)
+
+	key, err := DeriveNanoKey(seed, 0)
+	require.NoError(t, err)
+	require.Equal(t, _strToHex("3be4fc2ef3f3b7374e6fc4fb6e7bb153f8a2998b3b3dab50853eabe128024143"), key.PrivateKey())
+	require.Equal(t, _strToHex("5b65b0e8173ee0802c2c3e6c9080d1a16b06de1176c938a924f58670904e82c4"), key.PublicKey())
+	address, err := key.Address()
+	require.NoError(t, err)
+	require.Equal(t, "nano_1pu7p5n3ghq1i1p4rhmek41f5add1uh34xpb94nkbxe8g4a6x1p69emk8y1d", address)
+}
+
+func TestNanoPublicKeyFromAddress(t *testing.T) {
+	type test struct {
+		name    string
+		address string
+		err     string
+		pubKey  []byte
+	}
+
+	tests := []test{
+		{
+			name:    "Valid",
+			address: "nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7",
+			pubKey:  _strToHex("c008b814a7d269a1fa3c6528b19201a24d797912db9996ff02a1ff356e45552b"),
+		},
+		{
+			name:    "LegacyPrefix",
+			address: "xrb_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7",
+			pubKey:  _strToHex("c008b814a7d269a1fa3c6528b19201a24d797912db9996ff02a1ff356e45552b"),
+		},
+		{
+			name:    "BadPrefix",
+			address: "xno_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7",
+			err:     "unknown prefix: invalid Nano address",
+		},
+		{
+			name:    "BadLength",
+			address: "nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b",
+			err:     "incorrect length: invalid Nano address",
+		},
+		{
+			name:    "BadCharacter",
+			address: "nano_2i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7",
+			err:     "invalid public key: invalid Nano address",
+		},
+		{
+			name:    "BadChecksum",
+			address: "nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b8",
+			err:     "invalid checksum: invalid Nano address",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pubKey, err := NanoPublicKeyFromAddress(test.address)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.pubKey, pubKey)
+		})
+	}
+}
+
+func TestNanoSign(t *testing.T) {
+	key, err := NanoKeyFromSeed(make([]byte, 32), 0)
+	require.NoError(t, err)
+
+	msg := []byte("test message")
+	sig := key.Sign(msg)
+	require.Len(t, sig, 64)
+	require.NoError(t, VerifyNano(key.PublicKey(), msg, sig))
+	require.EqualError(t, VerifyNano(key.PublicKey(), []byte("other message"), sig), "invalid signature")
+	require.EqualError(t, VerifyNano(key.PublicKey(), msg, sig[1:]), "signature must be 64 bytes (passed 63)")
+
+	other, err := NanoKeyFromSeed(make([]byte, 32), 1)
+	require.NoError(t, err)
+	require.EqualError(t, VerifyNano(other.PublicKey(), msg, sig), "invalid signature")
+}
+
+// The vectors are the open blocks of the Nano dev and live networks' genesis
+// accounts, which sign the Blake2b-256 hash of source || representative ||
+// account, all of which are the account's public key.
+func TestNanoSignKnownAnswers(t *testing.T) {
+	// The dev network genesis private key is published with the node.
+	key := &NanoKey{privateKey: _strToHex("34f0a37aad20f4a260f0a5b3cb3d7fb50673212263e58a380bc10474bb039ce4")}
+	pubKey := key.PublicKey()
+	require.Equal(t, _strToHex("b0311ea55708d6a53c75cdbf88300259c6d018522fe3d4d0a242e431f9e8b6d0"), pubKey)
+	address, err := key.Address()
+	require.NoError(t, err)
+	require.Equal(t, "nano_3e3j5tkog48pnny9dmfzj1r16pg8t1e76dz5tmac6iq689wyjfpiij4txtdo", address)
+	hash := blake2b.Sum256(bytes.Repeat(pubKey, 3))
+	require.Equal(t, _strToHex("04270d7f11c4b2b472f2854c5a59f2a7e84226ce9ed799de75744bd7d85fc9d9"), hash[:])
+	sig := key.Sign(hash[:])
+	require.Equal(t, _strToHex("ecda914373a2f0ca1296475baee40500a7f0a7ad72a5a80c81d7fab7f6c802b2cc7db50f5dd0fb25b2ef11761fa7344a158dd5a700b21bd47de5bd0f63153a02"), sig)
+	require.NoError(t, VerifyNano(pubKey, hash[:], sig))
+
+	// The live network genesis signature verifies.
+	pubKey = _strToHex("e89208dd038fbb269987689621d52292ae9c35941a7484756ecced92a65093ba")
+	address, err = NanoAddress(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3", address)
+	hash = blake2b.Sum256(bytes.Repeat(pubKey, 3))
+	require.Equal(t, _strToHex("991cf190094c00f0b68e2e5f75f6bee95a2e0bd93ceaa4a6734db9f19b728948"), hash[:])
+	sig = _strToHex("9f0c933c8ade004d808ea1985fa746a7e95ba2a38f867640f53ec8f180bdfe9e2c1268dead7c2664f356e37aba362bc58e46dba03e523a7b5a19e4b6eb12bb02")
+	require.NoError(t, VerifyNano(pubKey, hash[:], sig))
+}
+
+func TestNanoAddressLength(t *testing.T) {
+	_, err := NanoAddress(make([]byte, 31))
+	require.EqualError(t, err, "public key must be 32 bytes (passed 31)")
+	_, err = NanoAddress(make([]byte, 33))
+	require.EqualError(t, err, "public key must be 32 bytes (passed 33)")
+}
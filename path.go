@@ -14,6 +14,7 @@
 package ed25519hd
 
 import (
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -26,6 +27,9 @@ var (
 	ErrElementTooLarge = errors.New("path element cannot be larger than 4294967295")
 
 	pathRegex = regexp.MustCompile(`^m((\/[0-9]+')|(\/[0-9]+'){2}|((\/[0-9]+'){3}(\/[0-9]+){0,2}))$`)
+
+	// bip32PathRegex matches paths of any depth with hardened or non-hardened elements.
+	bip32PathRegex = regexp.MustCompile(`^m(\/[0-9]+'?)*$`)
 )
 
 func isValidPath(path string) bool {
@@ -59,3 +63,46 @@ func elementsForPath(path string) ([]uint32, error) {
 
 	return results, nil
 }
+
+// pathForElements returns the path for the given elements, in the form
+// accepted by DeriveKey.
+func pathForElements(elements []uint32) string {
+	var builder strings.Builder
+	builder.WriteString("m")
+	for i, element := range elements {
+		builder.WriteString(fmt.Sprintf("/%d", element))
+		// Only the first three elements can be marked as hardened.
+		if i < 3 {
+			builder.WriteString("'")
+		}
+	}
+
+	return builder.String()
+}
+
+// indicesForBIP32Path returns the child indices for a path of any depth,
+// where hardened elements are marked with ' and have the hardened offset
+// added.
+func indicesForBIP32Path(path string) ([]uint32, error) {
+	if !bip32PathRegex.MatchString(path) {
+		return nil, ErrInvalidPath
+	}
+
+	elements := strings.Split(path, "/")[1:]
+	results := make([]uint32, len(elements))
+	for i, element := range elements {
+		result, err := strconv.ParseUint(strings.TrimSuffix(element, "'"), 10, 32)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse element")
+		}
+		if result >= uint64(hardenedOffset) {
+			return nil, ErrHardenedElementTooLarge
+		}
+		results[i] = uint32(result)
+		if strings.HasSuffix(element, "'") {
+			results[i] += hardenedOffset
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,255 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrUnknownWalletPreset is returned when a wallet preset is not known.
+var ErrUnknownWalletPreset = errors.New("unknown wallet preset")
+
+// indexPlaceholder marks the position of the index in a wallet preset template.
+const indexPlaceholder = "{index}"
+
+// DerivationScheme is the scheme with which a wallet derives its keys.
+type DerivationScheme string
+
+const (
+	// SchemeSLIP10 derives keys with SLIP-0010, as per DeriveKey.
+	SchemeSLIP10 DerivationScheme = "slip10"
+	// SchemeLedger derives keys with BIP32-Ed25519 from the master key
+	// generated by Ledger devices, as per DeriveExtendedKey.
+	SchemeLedger DerivationScheme = "ledger"
+	// SchemeSeed uses the first 32 bytes of the seed as the private key,
+	// without derivation.
+	SchemeSeed DerivationScheme = "seed"
+)
+
+// WalletPreset describes how a wallet derives the accounts for a chain.
+type WalletPreset struct {
+	// Name is the unique name of the preset.
+	Name string
+	// Wallet is the name of the wallet.
+	Wallet string
+	// Chain is the name of the chain, as per the coin type registry.
+	Chain string
+	// Scheme is the scheme with which the wallet derives its keys.
+	Scheme DerivationScheme
+	// Template is the derivation path, with {index} marking the position of
+	// the index that the wallet increments for each new account.  It is empty
+	// for SchemeSeed, which has a single account.
+	Template string
+	// Address encodes a public key as an address on the chain.
+	Address func(pubKey []byte) string
+}
+
+// Path returns the derivation path for the account with the given index.
+func (p *WalletPreset) Path(index uint32) string {
+	return strings.Replace(p.Template, indexPlaceholder, fmt.Sprintf("%d", index), 1)
+}
+
+// PublicKey returns the public key for the account with the given index,
+// given a BIP-39 seed.
+func (p *WalletPreset) PublicKey(seed []byte, index uint32) ([]byte, error) {
+	switch p.Scheme {
+	case SchemeSLIP10:
+		return slip10PublicKey(seed, p.Path(index))
+	case SchemeLedger:
+		return ledgerPublicKey(seed, p.Path(index))
+	case SchemeSeed:
+		if index > 0 {
+			return nil, fmt.Errorf("%s has a single account", p.Name)
+		}
+
+		return seedPublicKey(seed)
+	default:
+		return nil, fmt.Errorf("unknown derivation scheme %s", p.Scheme)
+	}
+}
+
+// walletPresets are the known wallet presets.  SLIP-0010 templates are
+// written in the form accepted by DeriveKey, as all elements of the path are
+// hardened when derived.  Ledger templates are written in the form accepted by
+// DeriveExtendedKey, in which elements without a hardened marker are not
+// hardened.
+var walletPresets = []*WalletPreset{
+	{
+		Name:     "solana-bip44",
+		Wallet:   "Phantom, Solflare, Solana CLI with a derivation path",
+		Chain:    "solana",
+		Scheme:   SchemeSLIP10,
+		Template: "m/44'/501'/{index}'/0",
+		Address:  SolanaAddress,
+	},
+	{
+		Name:     "ledger-live-solana",
+		Wallet:   "Ledger Live",
+		Chain:    "solana",
+		Scheme:   SchemeSLIP10,
+		Template: "m/44'/501'/{index}'",
+		Address:  SolanaAddress,
+	},
+	{
+		// solana-keygen uses the seed directly unless given a derivation path.
+		Name:    "solana-cli",
+		Wallet:  "Solana CLI",
+		Chain:   "solana",
+		Scheme:  SchemeSeed,
+		Address: SolanaAddress,
+	},
+	{
+		Name:     "stellar",
+		Wallet:   "SEP-0005",
+		Chain:    "stellar",
+		Scheme:   SchemeSLIP10,
+		Template: "m/44'/148'/{index}'",
+		Address:  StellarAddress,
+	},
+	{
+		Name:     "ledger-live-algorand",
+		Wallet:   "Ledger Live",
+		Chain:    "algorand",
+		Scheme:   SchemeLedger,
+		Template: "m/44'/283'/{index}'/0/0",
+		Address:  AlgorandAddress,
+	},
+	{
+		Name:     "trust-wallet-algorand",
+		Wallet:   "Trust Wallet",
+		Chain:    "algorand",
+		Scheme:   SchemeSLIP10,
+		Template: "m/44'/283'/{index}'/0/0",
+		Address:  AlgorandAddress,
+	},
+	{
+		Name:     "near-wallet",
+		Wallet:   "NEAR Wallet",
+		Chain:    "near",
+		Scheme:   SchemeSLIP10,
+		Template: "m/44'/397'/{index}'",
+		Address:  NEARAddress,
+	},
+	{
+		Name:     "petra",
+		Wallet:   "Petra",
+		Chain:    "aptos",
+		Scheme:   SchemeSLIP10,
+		Template: "m/44'/637'/{index}'/0/0",
+		Address:  AptosAddress,
+	},
+	{
+		Name:     "sui-wallet",
+		Wallet:   "Sui Wallet",
+		Chain:    "sui",
+		Scheme:   SchemeSLIP10,
+		Template: "m/44'/784'/{index}'/0/0",
+		Address:  SuiAddress,
+	},
+}
+
+// slip10PublicKey derives a public key with SLIP-0010, as per DeriveKey.
+func slip10PublicKey(seed []byte, path string) ([]byte, error) {
+	key, err := DeriveKey(seed, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return key.PublicKey()
+}
+
+// ledgerPublicKey derives a public key with BIP32-Ed25519 from the master key
+// generated by Ledger devices.
+func ledgerPublicKey(seed []byte, path string) ([]byte, error) {
+	master, err := ledgerMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	key, err := DeriveExtendedKey(master, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return key.PublicKey(), nil
+}
+
+// seedPublicKey returns the public key for the first 32 bytes of the seed.
+func seedPublicKey(seed []byte) ([]byte, error) {
+	if len(seed) < 32 {
+		return nil, fmt.Errorf("seed must be at least 32 bytes (passed %d)", len(seed))
+	}
+
+	return Ed25519.publicKey(seed[:32])
+}
+
+// WalletPresets returns all known wallet presets.
+func WalletPresets() []*WalletPreset {
+	return walletPresets
+}
+
+// LookupWalletPreset returns the wallet preset with the given name.
+func LookupWalletPreset(name string) (*WalletPreset, error) {
+	for _, preset := range walletPresets {
+		if preset.Name == name {
+			return preset, nil
+		}
+	}
+
+	return nil, errors.Wrap(ErrUnknownWalletPreset, name)
+}
+
+// PresetAccount is an account derived using a wallet preset.
+type PresetAccount struct {
+	Preset    *WalletPreset
+	Index     uint32
+	Path      string
+	PublicKey []byte
+	Address   string
+}
+
+// ScanWalletPresets derives the first count accounts for every known wallet
+// preset from a mnemonic and passphrase.  Presets using SchemeSeed have a
+// single account, with an empty path.
+func ScanWalletPresets(mnemonic string, passphrase string, count uint32) ([]*PresetAccount, error) {
+	seed, err := SeedFromMnemonic(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*PresetAccount, 0, len(walletPresets)*int(count))
+	for _, preset := range walletPresets {
+		for index := uint32(0); index < count; index++ {
+			if preset.Scheme == SchemeSeed && index > 0 {
+				break
+			}
+			path := preset.Path(index)
+			pubKey, err := preset.PublicKey(seed, index)
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("failed to derive key for %s account %d", preset.Name, index))
+			}
+			accounts = append(accounts, &PresetAccount{
+				Preset:    preset,
+				Index:     index,
+				Path:      path,
+				PublicKey: pubKey,
+				Address:   preset.Address(pubKey),
+			})
+		}
+	}
+
+	return accounts, nil
+}
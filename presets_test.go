@@ -0,0 +1,175 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"crypto/sha512"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+func TestWalletPresets(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, preset := range WalletPresets() {
+		require.False(t, seen[preset.Name], preset.Name)
+		seen[preset.Name] = true
+		if preset.Scheme == SchemeSeed {
+			require.Empty(t, preset.Template, preset.Name)
+			continue
+		}
+		require.Contains(t, preset.Template, "{index}")
+		require.True(t, isValidPath(preset.Path(0)), preset.Name)
+		require.NoError(t, CheckCoinType(preset.Path(0)), preset.Name)
+		coinType, err := CoinType(preset.Chain)
+		require.NoError(t, err)
+		bip, err := ParseBIP44(preset.Path(0))
+		require.NoError(t, err)
+		require.Equal(t, coinType, bip.CoinType)
+	}
+}
+
+func TestLookupWalletPreset(t *testing.T) {
+	preset, err := LookupWalletPreset("solana-bip44")
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/501'/3'/0", preset.Path(3))
+
+	_, err = LookupWalletPreset("unknown")
+	require.EqualError(t, err, "unknown: unknown wallet preset")
+}
+
+// The expected addresses are those of the coin address derivation tests of
+// Trust Wallet's wallet-core, which strips leading zeros from Aptos addresses.
+func TestWalletPresetAddresses(t *testing.T) {
+	type test struct {
+		name    string
+		path    string
+		address string
+	}
+
+	tests := []test{
+		{
+			name:    "ledger-live-solana",
+			path:    "m/44'/501'/0'",
+			address: "2bUBiBNZyD29gP1oV6de7nxowMLoDBtopMMTGgMvjG5m",
+		},
+		{
+			name:    "stellar",
+			path:    "m/44'/148'/0'",
+			address: "GA3H6I4C5XUBYGVB66KXR27JV5KS3APSTKRUWOIXZ5MVWZKVTLXWKZ2P",
+		},
+		{
+			name:    "trust-wallet-algorand",
+			path:    "m/44'/283'/0'/0/0",
+			address: "JTJWO524JXIHVPGBDWFLJE7XUIA32ECOZOBLF2QP3V5TQBT3NKZSCG67BQ",
+		},
+		{
+			name:    "near-wallet",
+			path:    "m/44'/397'/0'",
+			address: "0c91f6106ff835c0195d5388565a2d69e25038a7e23d26198f85caf6594117ec",
+		},
+		{
+			name:    "petra",
+			path:    "m/44'/637'/0'/0/0",
+			address: "0x07968dab936c1bad187c60ce4082f307d030d780e91e694ae03aef16aba73f30",
+		},
+		{
+			name:    "sui-wallet",
+			path:    "m/44'/784'/0'/0/0",
+			address: "0xada112cfb90b44ba889cc5d39ac2bf46281e4a91f7919c693bcd9b8323e81ed2",
+		},
+	}
+
+	// SeedFromMnemonic only accepts 24 words, so the seed of this 12-word mnemonic is generated directly.
+	seed := pbkdf2.Key([]byte("shoot island position soft burden budget tooth cruel issue economy destroy above"),
+		[]byte("mnemonic"), 2048, 64, sha512.New)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			preset, err := LookupWalletPreset(test.name)
+			require.NoError(t, err)
+			require.Equal(t, test.path, preset.Path(0))
+			pubKey, err := preset.PublicKey(seed, 0)
+			require.NoError(t, err)
+			require.Equal(t, test.address, preset.Address(pubKey))
+		})
+	}
+}
+
+func TestWalletPresetSchemes(t *testing.T) {
+	seed := _strToHex("000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f")
+
+	// SLIP-0010 presets derive as per DeriveKey.
+	preset, err := LookupWalletPreset("solana-bip44")
+	require.NoError(t, err)
+	pubKey, err := preset.PublicKey(seed, 2)
+	require.NoError(t, err)
+	key, err := DeriveKey(seed, "m/44'/501'/2'/0")
+	require.NoError(t, err)
+	expected, err := key.PublicKey()
+	require.NoError(t, err)
+	require.Equal(t, expected, pubKey)
+
+	// Ledger presets derive with BIP32-Ed25519 from the Ledger master key.
+	preset, err = LookupWalletPreset("ledger-live-algorand")
+	require.NoError(t, err)
+	pubKey, err = preset.PublicKey(seed, 2)
+	require.NoError(t, err)
+	master, err := ledgerMasterKey(seed)
+	require.NoError(t, err)
+	extendedKey, err := DeriveExtendedKey(master, "m/44'/283'/2'/0/0")
+	require.NoError(t, err)
+	require.Equal(t, extendedKey.PublicKey(), pubKey)
+	trustPreset, err := LookupWalletPreset("trust-wallet-algorand")
+	require.NoError(t, err)
+	trustPubKey, err := trustPreset.PublicKey(seed, 2)
+	require.NoError(t, err)
+	require.NotEqual(t, trustPubKey, pubKey)
+
+	// Seed presets use the first 32 bytes of the seed as the private key.
+	preset, err = LookupWalletPreset("solana-cli")
+	require.NoError(t, err)
+	pubKey, err = preset.PublicKey(seed, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte(ed25519.NewKeyFromSeed(seed[:32]).Public().(ed25519.PublicKey)), pubKey)
+	_, err = preset.PublicKey(seed, 1)
+	require.EqualError(t, err, "solana-cli has a single account")
+	_, err = preset.PublicKey(seed[:31], 0)
+	require.EqualError(t, err, "seed must be at least 32 bytes (passed 31)")
+}
+
+func TestScanWalletPresets(t *testing.T) {
+	mnemonic := "bench hurt jump file august wise shallow faculty impulse spring exact slush thunder author capable act festival slice deposit sauce coconut afford frown better"
+
+	_, err := ScanWalletPresets("bench hurt", "", 2)
+	require.EqualError(t, err, "mnemonic must be 24 words (found 2)")
+
+	accounts, err := ScanWalletPresets(mnemonic, "", 2)
+	require.NoError(t, err)
+	// The Solana CLI preset has a single account.
+	require.Len(t, accounts, len(WalletPresets())*2-1)
+
+	found := false
+	for _, account := range accounts {
+		require.Equal(t, account.Preset.Path(account.Index), account.Path)
+		require.Len(t, account.PublicKey, 32)
+		if account.Preset.Name == "stellar" && account.Index == 0 {
+			// SEP-0005 test vector.
+			require.Equal(t, "GC3MMSXBWHL6CPOAVERSJITX7BH76YU252WGLUOM5CJX3E7UCYZBTPJQ", account.Address)
+			found = true
+		}
+	}
+	require.True(t, found)
+}
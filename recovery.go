@@ -0,0 +1,291 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// ErrPassphraseNotFound is returned when no candidate passphrase matches.
+var ErrPassphraseNotFound = errors.New("passphrase not found")
+
+const (
+	maskLower   = "abcdefghijklmnopqrstuvwxyz"
+	maskUpper   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	maskDigits  = "0123456789"
+	maskSymbols = " !\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
+)
+
+// PassphraseGenerator generates candidate passphrases.
+type PassphraseGenerator interface {
+	// Next returns the next candidate passphrase, or false if there are no more candidates.
+	Next() (string, bool)
+}
+
+// PassphraseRecovery contains the parameters for RecoverPassphrase.
+type PassphraseRecovery struct {
+	// Mnemonic is the known mnemonic.
+	Mnemonic string
+	// Path is the path at which PubKey was derived.
+	Path string
+	// PubKey is the known public key.
+	PubKey []byte
+	// Candidates generates the passphrases to try.
+	Candidates PassphraseGenerator
+	// Workers is the number of parallel workers; defaults to the number of CPUs.
+	Workers int
+	// Progress, if present, is called with the number of candidates tried so far.
+	// It is called from multiple goroutines so must be safe for concurrent use.
+	Progress func(tried uint64)
+}
+
+// RecoverPassphrase tries candidate passphrases for a mnemonic until one
+// generates the known public key at the known path.  It returns the first
+// matching passphrase, or ErrPassphraseNotFound if no candidate matches.
+func RecoverPassphrase(ctx context.Context, params *PassphraseRecovery) (string, error) {
+	if params == nil || params.Candidates == nil {
+		return "", errors.New("no candidate passphrases supplied")
+	}
+	if !isValidPath(params.Path) {
+		return "", ErrInvalidPath
+	}
+	if _, err := entropyFromMnemonic(params.Mnemonic); err != nil {
+		return "", err
+	}
+	workers := params.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	candidates := make(chan string)
+	go func() {
+		defer close(candidates)
+		for {
+			candidate, ok := params.Candidates.Next()
+			if !ok {
+				return
+			}
+			select {
+			case candidates <- candidate:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		tried    uint64
+		match    string
+		matchErr error
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for candidate := range candidates {
+				found, err := passphraseMatches(params, candidate)
+				if params.Progress != nil {
+					params.Progress(atomic.AddUint64(&tried, 1))
+				}
+				if err != nil || found {
+					once.Do(func() {
+						match = candidate
+						matchErr = err
+						cancel()
+					})
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Check for a match before cancellation, as a match cancels the context.
+	once.Do(func() {
+		matchErr = ctx.Err()
+		if matchErr == nil {
+			matchErr = ErrPassphraseNotFound
+		}
+	})
+	if matchErr != nil {
+		return "", matchErr
+	}
+
+	return match, nil
+}
+
+func passphraseMatches(params *PassphraseRecovery, candidate string) (bool, error) {
+	seed, err := SeedFromMnemonic(params.Mnemonic, candidate)
+	if err != nil {
+		return false, err
+	}
+	key, err := DeriveKey(seed, params.Path)
+	if err != nil {
+		return false, err
+	}
+	pubKey, err := key.PublicKey()
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(pubKey, params.PubKey), nil
+}
+
+type wordlistPassphrases struct {
+	words []string
+	index int
+}
+
+// WordlistPassphrases generates each of the supplied passphrases in turn.
+func WordlistPassphrases(words []string) PassphraseGenerator {
+	return &wordlistPassphrases{
+		words: words,
+	}
+}
+
+func (w *wordlistPassphrases) Next() (string, bool) {
+	if w.index >= len(w.words) {
+		return "", false
+	}
+	w.index++
+
+	return w.words[w.index-1], true
+}
+
+type maskPassphrases struct {
+	charsets [][]rune
+	counters []int
+	done     bool
+}
+
+// MaskPassphrases generates all passphrases matching a mask.  The mask is
+// made of literal characters and the placeholders ?l (lower-case letter),
+// ?u (upper-case letter), ?d (digit), ?s (symbol), ?a (any of these) and ??
+// (a literal question mark).
+func MaskPassphrases(mask string) (PassphraseGenerator, error) {
+	charsets := make([][]rune, 0)
+	runes := []rune(mask)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '?' {
+			charsets = append(charsets, []rune{runes[i]})
+			continue
+		}
+		if i == len(runes)-1 {
+			return nil, errors.New("mask ends with incomplete placeholder")
+		}
+		i++
+		switch runes[i] {
+		case 'l':
+			charsets = append(charsets, []rune(maskLower))
+		case 'u':
+			charsets = append(charsets, []rune(maskUpper))
+		case 'd':
+			charsets = append(charsets, []rune(maskDigits))
+		case 's':
+			charsets = append(charsets, []rune(maskSymbols))
+		case 'a':
+			charsets = append(charsets, []rune(maskLower+maskUpper+maskDigits+maskSymbols))
+		case '?':
+			charsets = append(charsets, []rune{'?'})
+		default:
+			return nil, fmt.Errorf("unknown mask placeholder ?%c", runes[i])
+		}
+	}
+
+	return &maskPassphrases{
+		charsets: charsets,
+		counters: make([]int, len(charsets)),
+	}, nil
+}
+
+func (m *maskPassphrases) Next() (string, bool) {
+	if m.done {
+		return "", false
+	}
+
+	candidate := make([]rune, len(m.charsets))
+	for i, charset := range m.charsets {
+		candidate[i] = charset[m.counters[i]]
+	}
+
+	// Advance the counters, rightmost first.
+	m.done = true
+	for i := len(m.counters) - 1; i >= 0; i-- {
+		m.counters[i]++
+		if m.counters[i] < len(m.charsets[i]) {
+			m.done = false
+			break
+		}
+		m.counters[i] = 0
+	}
+
+	return string(candidate), true
+}
+
+// TypoPassphrases generates a remembered passphrase followed by all variants
+// of it with a single typing error: a missing, extra, wrong or case-swapped
+// character, or two adjacent characters swapped.
+func TypoPassphrases(guess string) PassphraseGenerator {
+	seen := map[string]bool{guess: true}
+	candidates := []string{guess}
+	add := func(candidate string) {
+		if !seen[candidate] {
+			seen[candidate] = true
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	runes := []rune(guess)
+	typeable := []rune(maskLower + maskUpper + maskDigits + maskSymbols)
+	for i := range runes {
+		// Case swap.
+		if unicode.IsUpper(runes[i]) {
+			add(string(runes[:i]) + strings.ToLower(string(runes[i])) + string(runes[i+1:]))
+		} else if unicode.IsLower(runes[i]) {
+			add(string(runes[:i]) + strings.ToUpper(string(runes[i])) + string(runes[i+1:]))
+		}
+		// Transposition.
+		if i < len(runes)-1 {
+			add(string(runes[:i]) + string(runes[i+1]) + string(runes[i]) + string(runes[i+2:]))
+		}
+		// Deletion.
+		add(string(runes[:i]) + string(runes[i+1:]))
+	}
+	for i := 0; i <= len(runes); i++ {
+		for _, r := range typeable {
+			// Substitution.
+			if i < len(runes) {
+				add(string(runes[:i]) + string(r) + string(runes[i+1:]))
+			}
+			// Insertion.
+			add(string(runes[:i]) + string(r) + string(runes[i:]))
+		}
+	}
+
+	return WordlistPassphrases(candidates)
+}
@@ -0,0 +1,255 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func _drain(generator PassphraseGenerator) []string {
+	res := make([]string, 0)
+	for {
+		candidate, ok := generator.Next()
+		if !ok {
+			return res
+		}
+		res = append(res, candidate)
+	}
+}
+
+func TestMaskPassphrases(t *testing.T) {
+	type test struct {
+		name       string
+		mask       string
+		err        string
+		count      int
+		first      string
+		last       string
+		candidates []string
+	}
+
+	tests := []test{
+		{
+			name: "Incomplete",
+			mask: "abc?",
+			err:  "mask ends with incomplete placeholder",
+		},
+		{
+			name: "Unknown",
+			mask: "?x",
+			err:  "unknown mask placeholder ?x",
+		},
+		{
+			name:       "Literal",
+			mask:       "a??",
+			candidates: []string{"a?"},
+		},
+		{
+			name:  "Digits",
+			mask:  "pw?d?d",
+			count: 100,
+			first: "pw00",
+			last:  "pw99",
+		},
+		{
+			name:  "Mixed",
+			mask:  "?u?l",
+			count: 676,
+			first: "Aa",
+			last:  "Zz",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			generator, err := MaskPassphrases(test.mask)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			candidates := _drain(generator)
+			if test.candidates != nil {
+				require.Equal(t, test.candidates, candidates)
+			} else {
+				require.Len(t, candidates, test.count)
+				require.Equal(t, test.first, candidates[0])
+				require.Equal(t, test.last, candidates[len(candidates)-1])
+			}
+		})
+	}
+}
+
+func TestTypoPassphrases(t *testing.T) {
+	candidates := _drain(TypoPassphrases("test"))
+	require.Equal(t, "test", candidates[0])
+	require.Contains(t, candidates, "Test")
+	require.Contains(t, candidates, "tset")
+	require.Contains(t, candidates, "tst")
+	require.Contains(t, candidates, "tezt")
+	require.Contains(t, candidates, "test!")
+
+	seen := make(map[string]bool)
+	for _, candidate := range candidates {
+		require.False(t, seen[candidate], candidate)
+		seen[candidate] = true
+	}
+}
+
+func TestRecoverPassphrase(t *testing.T) {
+	mnemonic := "awesome tide fiction sibling panther movie stable market cause coffee hair clarify celery lady transfer extend save parent decide hollow effort spin notice matter"
+	path := "m/44'/1901'/0'"
+	seed, err := SeedFromMnemonic(mnemonic, "test")
+	require.NoError(t, err)
+	key, err := DeriveKey(seed, path)
+	require.NoError(t, err)
+	pubKey, err := key.PublicKey()
+	require.NoError(t, err)
+
+	mask, err := MaskPassphrases("te?l?l")
+	require.NoError(t, err)
+
+	type test struct {
+		name       string
+		params     *PassphraseRecovery
+		err        string
+		passphrase string
+	}
+
+	tests := []test{
+		{
+			name: "Nil",
+			err:  "no candidate passphrases supplied",
+		},
+		{
+			name: "InvalidPath",
+			params: &PassphraseRecovery{
+				Mnemonic:   mnemonic,
+				Path:       "m/44",
+				PubKey:     pubKey,
+				Candidates: WordlistPassphrases([]string{"test"}),
+			},
+			err: "invalid path",
+		},
+		{
+			name: "InvalidMnemonic",
+			params: &PassphraseRecovery{
+				Mnemonic:   "awesome tide",
+				Path:       path,
+				PubKey:     pubKey,
+				Candidates: WordlistPassphrases([]string{"test"}),
+			},
+			err: "mnemonic must be 24 words (found 2)",
+		},
+		{
+			name: "NotFound",
+			params: &PassphraseRecovery{
+				Mnemonic:   mnemonic,
+				Path:       path,
+				PubKey:     pubKey,
+				Candidates: WordlistPassphrases([]string{"foo", "bar"}),
+			},
+			err: "passphrase not found",
+		},
+		{
+			name: "Wordlist",
+			params: &PassphraseRecovery{
+				Mnemonic:   mnemonic,
+				Path:       path,
+				PubKey:     pubKey,
+				Candidates: WordlistPassphrases([]string{"foo", "bar", "test", "baz"}),
+				Workers:    2,
+			},
+			passphrase: "test",
+		},
+		{
+			name: "Typo",
+			params: &PassphraseRecovery{
+				Mnemonic:   mnemonic,
+				Path:       path,
+				PubKey:     pubKey,
+				Candidates: TypoPassphrases("tset"),
+			},
+			passphrase: "test",
+		},
+		{
+			name: "Mask",
+			params: &PassphraseRecovery{
+				Mnemonic:   mnemonic,
+				Path:       path,
+				PubKey:     pubKey,
+				Candidates: mask,
+			},
+			passphrase: "test",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			passphrase, err := RecoverPassphrase(context.Background(), test.params)
+			if test.err == "" {
+				require.NoError(t, err)
+				require.Equal(t, test.passphrase, passphrase)
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestRecoverPassphraseProgress(t *testing.T) {
+	mnemonic := "awesome tide fiction sibling panther movie stable market cause coffee hair clarify celery lady transfer extend save parent decide hollow effort spin notice matter"
+
+	var reported uint64
+	_, err := RecoverPassphrase(context.Background(), &PassphraseRecovery{
+		Mnemonic:   mnemonic,
+		Path:       "m/44'/1901'/0'",
+		PubKey:     make([]byte, 32),
+		Candidates: WordlistPassphrases([]string{"a", "b", "c", "d", "e"}),
+		Progress: func(tried uint64) {
+			for {
+				current := atomic.LoadUint64(&reported)
+				if tried <= current || atomic.CompareAndSwapUint64(&reported, current, tried) {
+					return
+				}
+			}
+		},
+	})
+	require.EqualError(t, err, "passphrase not found")
+	require.Equal(t, uint64(5), atomic.LoadUint64(&reported))
+}
+
+func TestRecoverPassphraseCancel(t *testing.T) {
+	mnemonic := "awesome tide fiction sibling panther movie stable market cause coffee hair clarify celery lady transfer extend save parent decide hollow effort spin notice matter"
+	mask, err := MaskPassphrases("?a?a?a?a")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err = RecoverPassphrase(ctx, &PassphraseRecovery{
+		Mnemonic:   mnemonic,
+		Path:       "m/44'/1901'/0'",
+		PubKey:     make([]byte, 32),
+		Candidates: mask,
+		Progress: func(tried uint64) {
+			if tried == 10 {
+				cancel()
+			}
+		},
+	})
+	require.EqualError(t, err, "context canceled")
+}
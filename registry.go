@@ -0,0 +1,218 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// PathClaim is a claim by an owner on a path prefix.
+type PathClaim struct {
+	// Prefix is the claimed path prefix, for example m/44'/501'/7'.
+	Prefix string `json:"prefix" yaml:"prefix"`
+	// Owner is the application or team that owns the prefix.
+	Owner string `json:"owner" yaml:"owner"`
+	// Purpose describes what the keys beneath the prefix are used for.
+	Purpose string `json:"purpose" yaml:"purpose"`
+
+	elements []uint32
+}
+
+// PathRegistry records which owners have claimed which path prefixes, and
+// refuses overlapping claims.
+type PathRegistry struct {
+	mutex  sync.RWMutex
+	claims []*PathClaim
+}
+
+type pathRegistryFile struct {
+	Claims []*PathClaim `json:"claims" yaml:"claims"`
+}
+
+// NewPathRegistry creates an empty path registry.
+func NewPathRegistry() *PathRegistry {
+	return &PathRegistry{
+		claims: make([]*PathClaim, 0),
+	}
+}
+
+// NewPathRegistryFromJSON creates a path registry from JSON data of the form
+// {"claims":[{"prefix":"m/44'/501'/7'","owner":"...","purpose":"..."}]}.
+func NewPathRegistryFromJSON(data []byte) (*PathRegistry, error) {
+	file := &pathRegistryFile{}
+	if err := json.Unmarshal(data, file); err != nil {
+		return nil, errors.Wrap(err, "failed to parse JSON registry")
+	}
+
+	return newPathRegistryFromFile(file)
+}
+
+// NewPathRegistryFromYAML creates a path registry from YAML data with the
+// same structure as NewPathRegistryFromJSON.
+func NewPathRegistryFromYAML(data []byte) (*PathRegistry, error) {
+	file := &pathRegistryFile{}
+	if err := yaml.Unmarshal(data, file); err != nil {
+		return nil, errors.Wrap(err, "failed to parse YAML registry")
+	}
+
+	return newPathRegistryFromFile(file)
+}
+
+// LoadPathRegistry loads a path registry from a file, which is parsed as
+// JSON if it has a .json extension and as YAML otherwise.
+func LoadPathRegistry(filename string) (*PathRegistry, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read registry")
+	}
+	if strings.EqualFold(filepath.Ext(filename), ".json") {
+		return NewPathRegistryFromJSON(data)
+	}
+
+	return NewPathRegistryFromYAML(data)
+}
+
+func newPathRegistryFromFile(file *pathRegistryFile) (*PathRegistry, error) {
+	registry := NewPathRegistry()
+	for _, claim := range file.Claims {
+		if err := registry.Claim(claim); err != nil {
+			return nil, err
+		}
+	}
+
+	return registry, nil
+}
+
+// Claim adds a claim to the registry.  It fails if the prefix is equal to,
+// beneath or above a prefix that has already been claimed.
+func (r *PathRegistry) Claim(claim *PathClaim) error {
+	if claim == nil {
+		return errors.New("no claim supplied")
+	}
+	if claim.Owner == "" {
+		return fmt.Errorf("claim for %s has no owner", claim.Prefix)
+	}
+	elements, err := validElementsForPath(claim.Prefix)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("invalid prefix %s", claim.Prefix))
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, existing := range r.claims {
+		if elementsHavePrefix(elements, existing.elements) || elementsHavePrefix(existing.elements, elements) {
+			return fmt.Errorf("prefix %s claimed by %s overlaps prefix %s claimed by %s",
+				claim.Prefix, claim.Owner, existing.Prefix, existing.Owner)
+		}
+	}
+
+	r.claims = append(r.claims, &PathClaim{
+		Prefix:   claim.Prefix,
+		Owner:    claim.Owner,
+		Purpose:  claim.Purpose,
+		elements: elements,
+	})
+
+	return nil
+}
+
+// Claims returns copies of the claims in the registry.
+func (r *PathRegistry) Claims() []*PathClaim {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	res := make([]*PathClaim, len(r.claims))
+	for i, claim := range r.claims {
+		res[i] = claim.copy()
+	}
+
+	return res
+}
+
+// ClaimFor returns a copy of the claim that covers a path.
+func (r *PathRegistry) ClaimFor(path string) (*PathClaim, error) {
+	elements, err := validElementsForPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	for _, claim := range r.claims {
+		if elementsHavePrefix(elements, claim.elements) {
+			return claim.copy(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("path %s is not covered by any registered claim", path)
+}
+
+// DeriveKey derives a key given a seed and a derivation path, as per DeriveKey,
+// but requires that the path is covered by a claim owned by the given owner.
+func (r *PathRegistry) DeriveKey(seed []byte, owner string, path string) (*Key, error) {
+	claim, err := r.ClaimFor(path)
+	if err != nil {
+		return nil, err
+	}
+	if claim.Owner != owner {
+		return nil, fmt.Errorf("path %s is claimed by %s, not %s", path, claim.Owner, owner)
+	}
+
+	return DeriveKey(seed, path)
+}
+
+// copy returns a copy of the claim, so that callers cannot alter the registry.
+func (c *PathClaim) copy() *PathClaim {
+	return &PathClaim{
+		Prefix:   c.Prefix,
+		Owner:    c.Owner,
+		Purpose:  c.Purpose,
+		elements: append([]uint32{}, c.elements...),
+	}
+}
+
+// validElementsForPath resolves, validates and parses a path.
+func validElementsForPath(path string) ([]uint32, error) {
+	path, err := resolveCoinType(path)
+	if err != nil {
+		return nil, err
+	}
+	if !isValidPath(path) {
+		return nil, ErrInvalidPath
+	}
+
+	return elementsForPath(path)
+}
+
+// elementsHavePrefix returns true if elements starts with prefix.
+func elementsHavePrefix(elements []uint32, prefix []uint32) bool {
+	if len(prefix) > len(elements) {
+		return false
+	}
+	for i := range prefix {
+		if elements[i] != prefix[i] {
+			return false
+		}
+	}
+
+	return true
+}
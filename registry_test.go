@@ -0,0 +1,144 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathRegistryClaim(t *testing.T) {
+	registry := NewPathRegistry()
+	require.NoError(t, registry.Claim(&PathClaim{Prefix: "m/44'/501'/7'", Owner: "payments", Purpose: "hot wallet"}))
+
+	type test struct {
+		name  string
+		claim *PathClaim
+		err   string
+	}
+
+	tests := []test{
+		{
+			name: "Nil",
+			err:  "no claim supplied",
+		},
+		{
+			name:  "NoOwner",
+			claim: &PathClaim{Prefix: "m/44'/501'/8'"},
+			err:   "claim for m/44'/501'/8' has no owner",
+		},
+		{
+			name:  "InvalidPrefix",
+			claim: &PathClaim{Prefix: "m/44", Owner: "auth"},
+			err:   "invalid prefix m/44: invalid path",
+		},
+		{
+			name:  "Duplicate",
+			claim: &PathClaim{Prefix: "m/44'/solana'/7'", Owner: "auth"},
+			err:   "prefix m/44'/solana'/7' claimed by auth overlaps prefix m/44'/501'/7' claimed by payments",
+		},
+		{
+			name:  "Beneath",
+			claim: &PathClaim{Prefix: "m/44'/501'/7'/0", Owner: "auth"},
+			err:   "prefix m/44'/501'/7'/0 claimed by auth overlaps prefix m/44'/501'/7' claimed by payments",
+		},
+		{
+			name:  "Above",
+			claim: &PathClaim{Prefix: "m/44'/501'", Owner: "auth"},
+			err:   "prefix m/44'/501' claimed by auth overlaps prefix m/44'/501'/7' claimed by payments",
+		},
+		{
+			name:  "Sibling",
+			claim: &PathClaim{Prefix: "m/44'/501'/8'", Owner: "auth"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := registry.Claim(test.claim)
+			if test.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+		})
+	}
+
+	require.Len(t, registry.Claims(), 2)
+}
+
+func TestPathRegistryDeriveKey(t *testing.T) {
+	seed := _strToHex("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000")
+	registry := NewPathRegistry()
+	require.NoError(t, registry.Claim(&PathClaim{Prefix: "m/44'/1901'", Owner: "payments"}))
+
+	key, err := registry.DeriveKey(seed, "payments", "m/44'/1901'/0'/0/1")
+	require.NoError(t, err)
+	expected, err := DeriveKey(seed, "m/44'/1901'/0'/0/1")
+	require.NoError(t, err)
+	require.Equal(t, expected, key)
+
+	_, err = registry.DeriveKey(seed, "auth", "m/44'/1901'/0'")
+	require.EqualError(t, err, "path m/44'/1901'/0' is claimed by payments, not auth")
+
+	_, err = registry.DeriveKey(seed, "payments", "m/44'/501'/0'")
+	require.EqualError(t, err, "path m/44'/501'/0' is not covered by any registered claim")
+
+	_, err = registry.DeriveKey(seed, "payments", "m/44")
+	require.EqualError(t, err, "invalid path")
+
+	claim, err := registry.ClaimFor("m/44'/1901'/3'")
+	require.NoError(t, err)
+	require.Equal(t, "payments", claim.Owner)
+
+	// Altering returned claims does not alter the registry.
+	claim.Owner = "auth"
+	registry.Claims()[0].Owner = "auth"
+	_, err = registry.DeriveKey(seed, "auth", "m/44'/1901'/0'")
+	require.EqualError(t, err, "path m/44'/1901'/0' is claimed by payments, not auth")
+}
+
+func TestLoadPathRegistry(t *testing.T) {
+	dir := t.TempDir()
+
+	yamlFile := filepath.Join(dir, "registry.yaml")
+	require.NoError(t, os.WriteFile(yamlFile, []byte(`claims:
+  - prefix: "m/44'/501'/7'"
+    owner: payments
+    purpose: hot wallet
+  - prefix: "m/13'"
+    owner: auth
+    purpose: login keys
+`), 0o600))
+	registry, err := LoadPathRegistry(yamlFile)
+	require.NoError(t, err)
+	require.Len(t, registry.Claims(), 2)
+	require.Equal(t, "login keys", registry.Claims()[1].Purpose)
+
+	jsonFile := filepath.Join(dir, "registry.json")
+	require.NoError(t, os.WriteFile(jsonFile, []byte(`{"claims":[{"prefix":"m/44'/501'/7'","owner":"payments"},{"prefix":"m/44'/501'/7'/1","owner":"auth"}]}`), 0o600))
+	_, err = LoadPathRegistry(jsonFile)
+	require.EqualError(t, err, "prefix m/44'/501'/7'/1 claimed by auth overlaps prefix m/44'/501'/7' claimed by payments")
+
+	badFile := filepath.Join(dir, "bad.json")
+	require.NoError(t, os.WriteFile(badFile, []byte(`{`), 0o600))
+	_, err = LoadPathRegistry(badFile)
+	require.EqualError(t, err, "failed to parse JSON registry: unexpected end of JSON input")
+
+	_, err = LoadPathRegistry(filepath.Join(dir, "missing.yaml"))
+	require.Error(t, err)
+}
@@ -0,0 +1,158 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ed25519"
+)
+
+// ErrInvalidSignature is returned when a signature does not verify.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// RotationPeriod is the period for which a rotating key is current.
+type RotationPeriod struct {
+	duration time.Duration
+	months   int
+}
+
+var (
+	// RotateDaily rotates keys at midnight UTC.
+	RotateDaily = RotationPeriod{duration: 24 * time.Hour}
+	// RotateWeekly rotates keys every 7 days from the Unix epoch.
+	RotateWeekly = RotationPeriod{duration: 7 * 24 * time.Hour}
+	// RotateMonthly rotates keys at the start of each calendar month UTC.
+	RotateMonthly = RotationPeriod{months: 1}
+)
+
+// RotateEvery rotates keys every duration from the Unix epoch.
+func RotateEvery(duration time.Duration) RotationPeriod {
+	return RotationPeriod{duration: duration}
+}
+
+// KeyRotation derives keys that rotate over time, at paths of the form
+// m/purpose'/app'/epoch', where epoch is the number of periods since the
+// Unix epoch.
+type KeyRotation struct {
+	Purpose uint32
+	App     uint32
+	Period  RotationPeriod
+}
+
+// Epoch returns the epoch covering the given time.
+func (r *KeyRotation) Epoch(t time.Time) (uint32, error) {
+	if t.Before(time.Unix(0, 0)) {
+		return 0, errors.New("time cannot be before the Unix epoch")
+	}
+
+	var epoch int64
+	switch {
+	case r.Period.months > 0:
+		t = t.UTC()
+		epoch = (int64(t.Year()-1970)*12 + int64(t.Month()-1)) / int64(r.Period.months)
+	case r.Period.duration > 0:
+		epoch = int64(time.Duration(t.UnixNano()) / r.Period.duration)
+	default:
+		return 0, errors.New("rotation period not set")
+	}
+	if epoch >= int64(hardenedOffset) {
+		return 0, ErrHardenedElementTooLarge
+	}
+
+	return uint32(epoch), nil
+}
+
+// EpochStart returns the time at which the given epoch starts.
+func (r *KeyRotation) EpochStart(epoch uint32) time.Time {
+	if r.Period.months > 0 {
+		return time.Date(1970, time.Month(int(epoch)*r.Period.months+1), 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	return time.Unix(0, int64(epoch)*int64(r.Period.duration)).UTC()
+}
+
+// Path returns the derivation path for the given epoch.
+func (r *KeyRotation) Path(epoch uint32) (string, error) {
+	if r.Purpose >= hardenedOffset || r.App >= hardenedOffset || epoch >= hardenedOffset {
+		return "", ErrHardenedElementTooLarge
+	}
+
+	return fmt.Sprintf("m/%d'/%d'/%d'", r.Purpose, r.App, epoch), nil
+}
+
+// Key derives the key for the given epoch.
+func (r *KeyRotation) Key(seed []byte, epoch uint32) (*Key, error) {
+	path, err := r.Path(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return DeriveKey(seed, path)
+}
+
+// Keys derives the previous, current and next keys for the given time.
+// The previous key is nil during the first epoch.
+func (r *KeyRotation) Keys(seed []byte, t time.Time) (*Key, *Key, *Key, error) {
+	epoch, err := r.Epoch(t)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var previous *Key
+	if epoch > 0 {
+		previous, err = r.Key(seed, epoch-1)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	current, err := r.Key(seed, epoch)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	next, err := r.Key(seed, epoch+1)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return previous, current, next, nil
+}
+
+// VerifyAt verifies a signature made at the given time, using the public key
+// for the epoch covering that time as returned by pubKeyForEpoch.  It returns
+// the epoch of the key that verified the signature.
+func (r *KeyRotation) VerifyAt(pubKeyForEpoch func(epoch uint32) ([]byte, error),
+	signedAt time.Time,
+	msg []byte,
+	sig []byte,
+) (uint32, error) {
+	epoch, err := r.Epoch(signedAt)
+	if err != nil {
+		return 0, err
+	}
+	pubKey, err := pubKeyForEpoch(epoch)
+	if err != nil {
+		return 0, errors.Wrap(err, fmt.Sprintf("failed to obtain public key for epoch %d", epoch))
+	}
+	if len(pubKey) != ed25519.PublicKeySize {
+		return 0, fmt.Errorf("public key for epoch %d must be %d bytes (found %d)", epoch, ed25519.PublicKeySize, len(pubKey))
+	}
+	if !ed25519.Verify(pubKey, msg, sig) {
+		return 0, ErrInvalidSignature
+	}
+
+	return epoch, nil
+}
@@ -0,0 +1,147 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ed25519"
+)
+
+func TestKeyRotationEpoch(t *testing.T) {
+	type test struct {
+		name     string
+		rotation *KeyRotation
+		time     time.Time
+		err      string
+		epoch    uint32
+		path     string
+		start    time.Time
+	}
+
+	tests := []test{
+		{
+			name:     "NoPeriod",
+			rotation: &KeyRotation{Purpose: 1, App: 2},
+			time:     time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+			err:      "rotation period not set",
+		},
+		{
+			name:     "BeforeEpoch",
+			rotation: &KeyRotation{Purpose: 1, App: 2, Period: RotateDaily},
+			time:     time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC),
+			err:      "time cannot be before the Unix epoch",
+		},
+		{
+			name:     "Daily",
+			rotation: &KeyRotation{Purpose: 1, App: 2, Period: RotateDaily},
+			time:     time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+			epoch:    20745,
+			path:     "m/1'/2'/20745'",
+			start:    time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Monthly",
+			rotation: &KeyRotation{Purpose: 1, App: 2, Period: RotateMonthly},
+			time:     time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+			epoch:    681,
+			path:     "m/1'/2'/681'",
+			start:    time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Hourly",
+			rotation: &KeyRotation{Purpose: 1, App: 2, Period: RotateEvery(time.Hour)},
+			time:     time.Date(1970, 1, 2, 1, 30, 0, 0, time.UTC),
+			epoch:    25,
+			path:     "m/1'/2'/25'",
+			start:    time.Date(1970, 1, 2, 1, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			epoch, err := test.rotation.Epoch(test.time)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.epoch, epoch)
+			path, err := test.rotation.Path(epoch)
+			require.NoError(t, err)
+			require.Equal(t, test.path, path)
+			require.Equal(t, test.start, test.rotation.EpochStart(epoch))
+		})
+	}
+}
+
+func TestKeyRotationKeys(t *testing.T) {
+	seed := _strToHex("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000")
+	rotation := &KeyRotation{Purpose: 44, App: 1901, Period: RotateDaily}
+
+	previous, current, next, err := rotation.Keys(seed, time.Unix(0, 0).Add(36*time.Hour))
+	require.NoError(t, err)
+	expected, err := DeriveKey(seed, "m/44'/1901'/0'")
+	require.NoError(t, err)
+	require.Equal(t, expected, previous)
+	expected, err = DeriveKey(seed, "m/44'/1901'/1'")
+	require.NoError(t, err)
+	require.Equal(t, expected, current)
+	expected, err = DeriveKey(seed, "m/44'/1901'/2'")
+	require.NoError(t, err)
+	require.Equal(t, expected, next)
+
+	previous, _, _, err = rotation.Keys(seed, time.Unix(0, 0))
+	require.NoError(t, err)
+	require.Nil(t, previous)
+
+	_, err = (&KeyRotation{Purpose: 0x80000000, Period: RotateDaily}).Key(seed, 0)
+	require.EqualError(t, err, "hardened path element cannot be larger than 2147483647")
+}
+
+func TestKeyRotationVerifyAt(t *testing.T) {
+	seed := _strToHex("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000")
+	rotation := &KeyRotation{Purpose: 44, App: 1901, Period: RotateDaily}
+	signedAt := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	key, err := rotation.Key(seed, 20745)
+	require.NoError(t, err)
+	keySeed := key.Seed()
+	msg := []byte("message")
+	sig := ed25519.Sign(ed25519.NewKeyFromSeed(keySeed[:]), msg)
+
+	pubKeyForEpoch := func(epoch uint32) ([]byte, error) {
+		key, err := rotation.Key(seed, epoch)
+		if err != nil {
+			return nil, err
+		}
+		return key.PublicKey()
+	}
+
+	epoch, err := rotation.VerifyAt(pubKeyForEpoch, signedAt, msg, sig)
+	require.NoError(t, err)
+	require.Equal(t, uint32(20745), epoch)
+
+	_, err = rotation.VerifyAt(pubKeyForEpoch, signedAt.Add(24*time.Hour), msg, sig)
+	require.EqualError(t, err, "invalid signature")
+
+	_, err = rotation.VerifyAt(func(uint32) ([]byte, error) { return nil, errors.New("unknown") }, signedAt, msg, sig)
+	require.EqualError(t, err, "failed to obtain public key for epoch 20745: unknown")
+
+	_, err = rotation.VerifyAt(func(uint32) ([]byte, error) { return []byte{0x01}, nil }, signedAt, msg, sig)
+	require.EqualError(t, err, "public key for epoch 20745 must be 32 bytes (found 1)")
+}
@@ -0,0 +1,273 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrPathNotFound is returned when no path in the search space produces the public key.
+var ErrPathNotFound = errors.New("path not found")
+
+// PathSearch defines the search space for FindPath.
+type PathSearch struct {
+	// Purposes are the purposes to search; defaults to 44.
+	Purposes []uint32
+	// CoinTypes are the coin types to search; defaults to all registered coin types.
+	CoinTypes []uint32
+	// MaxAccount is the highest account to search.
+	MaxAccount uint32
+	// MaxChange is the highest change value to search.
+	MaxChange uint32
+	// MaxIndex is the highest index to search.
+	MaxIndex uint32
+	// MaxDepth is the maximum number of path elements, from 1 to 5; defaults to 5.
+	MaxDepth int
+	// Presets searches the paths of all SLIP-0010 wallet presets, for indices
+	// up to MaxAccount, before searching the rest of the space.
+	Presets bool
+	// Workers is the number of parallel workers; defaults to the number of CPUs.
+	Workers int
+}
+
+// searchJob is a parent key whose children are to be searched.
+type searchJob struct {
+	// seq is the position of the job in the search order.
+	seq      int
+	parent   *Key
+	elements []uint32
+}
+
+// FindPath searches for the path at which a public key was derived from a
+// seed.  Each depth is searched in turn, so the shortest matching path is
+// returned.  Parent keys are generated depth-first and handed to the workers
+// as they are needed, so memory use does not grow with the size of the search
+// space, and generation stops at the first match.
+func FindPath(ctx context.Context, seed []byte, pubKey []byte, search *PathSearch) (string, error) {
+	if search == nil {
+		search = &PathSearch{}
+	}
+	maxDepth := search.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = 5
+	}
+	if maxDepth < 1 || maxDepth > 5 {
+		return "", errors.New("maximum depth must be between 1 and 5")
+	}
+	workers := search.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	master, err := MasterKeyFromSeed(seed)
+	if err != nil {
+		return "", err
+	}
+
+	if search.Presets {
+		path, err := findPresetPath(ctx, seed, pubKey, search.MaxAccount)
+		if err != nil || path != "" {
+			return path, err
+		}
+	}
+
+	levels := searchLevels(search)
+	for depth := 1; depth <= maxDepth; depth++ {
+		match, err := searchDepth(ctx, master, levels[:depth], pubKey, workers)
+		if err != nil {
+			return "", err
+		}
+		if match != nil {
+			return pathForElements(match), nil
+		}
+	}
+
+	return "", ErrPathNotFound
+}
+
+// searchLevels returns the elements to search at each depth.
+func searchLevels(search *PathSearch) [][]uint32 {
+	purposes := search.Purposes
+	if len(purposes) == 0 {
+		purposes = []uint32{BIP44Purpose}
+	}
+	coinTypes := search.CoinTypes
+	if len(coinTypes) == 0 {
+		coinTypes = make([]uint32, len(coins))
+		for i, coin := range coins {
+			coinTypes[i] = coin.Index
+		}
+	}
+
+	return [][]uint32{
+		purposes,
+		coinTypes,
+		elementRange(search.MaxAccount),
+		elementRange(search.MaxChange),
+		elementRange(search.MaxIndex),
+	}
+}
+
+func elementRange(last uint32) []uint32 {
+	res := make([]uint32, last+1)
+	for i := range res {
+		res[i] = uint32(i)
+	}
+
+	return res
+}
+
+// searchDepth searches the keys at the last of the given levels in parallel,
+// returning the elements of the first key in the search order that matches
+// the public key.
+func searchDepth(ctx context.Context, master *Key, levels [][]uint32, pubKey []byte, workers int) ([]uint32, error) {
+	// genCtx stops the generation of jobs on the first match or error.
+	genCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mutex    sync.Mutex
+		match    []uint32
+		matchSeq = -1
+		firstErr error
+	)
+	setErr := func(err error) {
+		mutex.Lock()
+		if firstErr == nil {
+			firstErr = err
+		}
+		mutex.Unlock()
+		cancel()
+	}
+
+	jobs := make(chan *searchJob)
+	go func() {
+		defer close(jobs)
+		seq := 0
+		_, err := walkSearchParents(master, nil, levels[:len(levels)-1], func(parent *Key, elements []uint32) bool {
+			select {
+			case jobs <- &searchJob{seq: seq, parent: parent, elements: elements}:
+				seq++
+				return true
+			case <-genCtx.Done():
+				return false
+			}
+		})
+		if err != nil {
+			setErr(err)
+		}
+	}()
+
+	children := levels[len(levels)-1]
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				for _, element := range children {
+					// Jobs before a match carry on, as they may contain an earlier match.
+					mutex.Lock()
+					stop := firstErr != nil || (matchSeq != -1 && matchSeq < job.seq)
+					mutex.Unlock()
+					if stop || ctx.Err() != nil {
+						break
+					}
+
+					key, err := deriveKeyElements(job.parent, []uint32{element})
+					if err != nil {
+						setErr(err)
+						break
+					}
+					childPubKey, err := key.PublicKey()
+					if err != nil {
+						setErr(err)
+						break
+					}
+					if bytes.Equal(childPubKey, pubKey) {
+						mutex.Lock()
+						if matchSeq == -1 || job.seq < matchSeq {
+							matchSeq = job.seq
+							match = append(append(make([]uint32, 0, len(job.elements)+1), job.elements...), element)
+						}
+						mutex.Unlock()
+						cancel()
+
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return match, nil
+}
+
+// walkSearchParents calls fn, in search order, with each key at the depth of
+// the given levels beneath key.  It returns false if fn returned false, which
+// stops the walk.
+func walkSearchParents(key *Key, elements []uint32, levels [][]uint32, fn func(*Key, []uint32) bool) (bool, error) {
+	if len(levels) == 0 {
+		return fn(key, elements), nil
+	}
+	for _, element := range levels[0] {
+		child, err := deriveKeyElements(key, []uint32{element})
+		if err != nil {
+			return false, err
+		}
+		childElements := append(append(make([]uint32, 0, len(elements)+1), elements...), element)
+		carryOn, err := walkSearchParents(child, childElements, levels[1:], fn)
+		if err != nil || !carryOn {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// findPresetPath searches the paths of the SLIP-0010 wallet presets.
+func findPresetPath(ctx context.Context, seed []byte, pubKey []byte, maxIndex uint32) (string, error) {
+	for index := uint32(0); index <= maxIndex; index++ {
+		for _, preset := range walletPresets {
+			if preset.Scheme != SchemeSLIP10 {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return "", err
+			}
+			presetPubKey, err := preset.PublicKey(seed, index)
+			if err != nil {
+				return "", err
+			}
+			if bytes.Equal(presetPubKey, pubKey) {
+				return preset.Path(index), nil
+			}
+		}
+	}
+
+	return "", nil
+}
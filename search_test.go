@@ -0,0 +1,120 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindPath(t *testing.T) {
+	seed := _strToHex("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000")
+	pubKeyFor := func(path string) []byte {
+		key, err := DeriveKey(seed, path)
+		require.NoError(t, err)
+		pubKey, err := key.PublicKey()
+		require.NoError(t, err)
+		return pubKey
+	}
+
+	type test struct {
+		name   string
+		seed   []byte
+		pubKey []byte
+		search *PathSearch
+		err    string
+		path   string
+	}
+
+	tests := []test{
+		{
+			name:   "InvalidSeed",
+			pubKey: pubKeyFor("m/44'/1901'/0'"),
+			err:    "seed must be between 16 and 64 bytes (passed 0)",
+		},
+		{
+			name:   "InvalidDepth",
+			seed:   seed,
+			pubKey: pubKeyFor("m/44'/1901'/0'"),
+			search: &PathSearch{MaxDepth: 6},
+			err:    "maximum depth must be between 1 and 5",
+		},
+		{
+			name:   "Account",
+			seed:   seed,
+			pubKey: pubKeyFor("m/44'/1901'/1'"),
+			search: &PathSearch{CoinTypes: []uint32{501, 1901}, MaxAccount: 2},
+			path:   "m/44'/1901'/1'",
+		},
+		{
+			name:   "Index",
+			seed:   seed,
+			pubKey: pubKeyFor("m/44'/501'/1'/1/2"),
+			search: &PathSearch{CoinTypes: []uint32{148, 501}, MaxAccount: 2, MaxChange: 1, MaxIndex: 3, Workers: 3},
+			path:   "m/44'/501'/1'/1/2",
+		},
+		{
+			name:   "CoinType",
+			seed:   seed,
+			pubKey: pubKeyFor("m/44'/148'"),
+			path:   "m/44'/148'",
+		},
+		{
+			name:   "Preset",
+			seed:   seed,
+			pubKey: pubKeyFor("m/44'/784'/2'/0/0"),
+			search: &PathSearch{Presets: true, MaxAccount: 2, MaxDepth: 1},
+			path:   "m/44'/784'/2'/0/0",
+		},
+		{
+			// The match is the second key searched at depth 5, so the
+			// rest of the million keys at that depth are not derived.
+			name:   "LargeSpace",
+			seed:   seed,
+			pubKey: pubKeyFor("m/44'/501'/0'/0/1"),
+			search: &PathSearch{CoinTypes: []uint32{501}, MaxAccount: 999, MaxIndex: 999},
+			path:   "m/44'/501'/0'/0/1",
+		},
+		{
+			name:   "TooShallow",
+			seed:   seed,
+			pubKey: pubKeyFor("m/44'/501'/0'/0"),
+			search: &PathSearch{CoinTypes: []uint32{501}, MaxDepth: 3},
+			err:    "path not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path, err := FindPath(context.Background(), test.seed, test.pubKey, test.search)
+			if test.err == "" {
+				require.NoError(t, err)
+				require.Equal(t, test.path, path)
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestFindPathCancel(t *testing.T) {
+	seed := _strToHex("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := FindPath(ctx, seed, make([]byte, 32), &PathSearch{MaxAccount: 100, MaxIndex: 100})
+	require.EqualError(t, err, "context canceled")
+}
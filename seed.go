@@ -18,7 +18,6 @@ import (
 	"crypto/sha256"
 	"crypto/sha512"
 	"fmt"
-	"math/big"
 	"strings"
 
 	"golang.org/x/crypto/pbkdf2"
@@ -47,28 +46,88 @@ func ValidateMnemonic(mnemonic string) (bool, error) {
 		return false, fmt.Errorf("mnemonic must be 24 words (found %d)", len(words))
 	}
 
-	// Ensure that the checksum is valid
-	seed := big.NewInt(0)
+	if _, err := entropyFromWords(words); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// entropyFromMnemonic validates a mnemonic and returns its entropy.
+func entropyFromMnemonic(mnemonic string) ([]byte, error) {
+	mnemonic = norm.NFKD.String(mnemonic)
+	valid, err := ValidateMnemonic(mnemonic)
+	if !valid {
+		return nil, err
+	}
+
+	return entropyFromWords(strings.Split(mnemonic, " "))
+}
+
+// entropyFromWords returns the entropy encoded by a BIP-39 mnemonic, checking
+// its checksum.
+func entropyFromWords(words []string) ([]byte, error) {
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return nil, fmt.Errorf("mnemonic must be 12, 15, 18, 21 or 24 words (found %d)", len(words))
+	}
+
+	// Each word provides 11 bits; one bit in every 33 is checksum.
+	checksumBits := len(words) / 3
+	entropy := make([]byte, (len(words)*11-checksumBits)/8)
+	checksum := 0
+	bit := 0
 	for i, word := range words {
 		index, exists := reverseWordMap[word]
 		if !exists {
-			return false, fmt.Errorf("invalid mnemonic word %s at position %d", word, i+1)
+			return nil, fmt.Errorf("invalid mnemonic word %s at position %d", word, i+1)
+		}
+		for j := 10; j >= 0; j-- {
+			set := (index>>j)&0x01 == 1
+			if bit < len(entropy)*8 {
+				if set {
+					entropy[bit/8] |= 0x80 >> (bit % 8)
+				}
+			} else {
+				checksum <<= 1
+				if set {
+					checksum |= 1
+				}
+			}
+			bit++
 		}
-		indexVal := big.NewInt(int64(index))
-		seed = seed.Lsh(seed, 11)
-		seed = seed.Or(seed, indexVal)
 	}
 
-	// At this point the seed contains a checksum in the last 8 bits.  This should match the first 8 bits of the sha256() of the
-	// seed without the checksum
-	noChecksumSeed := big.NewInt(0).Set(seed)
-	noChecksumSeed = noChecksumSeed.Rsh(noChecksumSeed, 8)
-	checksum := sha256.Sum256(noChecksumSeed.Bytes())
-	if seed.Bytes()[32] != checksum[0] {
-		return false, fmt.Errorf("invalid mnemonic checksum")
+	// The checksum should match the first bits of the sha256() of the entropy.
+	hash := sha256.Sum256(entropy)
+	if int(hash[0]>>(8-checksumBits)) != checksum {
+		return nil, fmt.Errorf("invalid mnemonic checksum")
 	}
 
-	return true, nil
+	return entropy, nil
+}
+
+// mnemonicFromEntropy returns the BIP-39 mnemonic for the given entropy.
+func mnemonicFromEntropy(entropy []byte) (string, error) {
+	if len(entropy) < 16 || len(entropy) > 32 || len(entropy)%4 != 0 {
+		return "", fmt.Errorf("entropy must be 16, 20, 24, 28 or 32 bytes (passed %d)", len(entropy))
+	}
+
+	// Entropy is followed by a checksum of 1 bit per 32 bits of entropy.
+	checksumBits := len(entropy) / 4
+	hash := sha256.Sum256(entropy)
+	data := append(append([]byte{}, entropy...), hash[0])
+
+	words := make([]string, (len(entropy)*8+checksumBits)/11)
+	for i := range words {
+		index := 0
+		for j := 0; j < 11; j++ {
+			bit := i*11 + j
+			index = index<<1 | int(data[bit/8]>>(7-bit%8))&0x01
+		}
+		words[i] = englishWordList[index]
+	}
+
+	return strings.Join(words, " "), nil
 }
 
 var reverseWordMap = map[string]int{}
@@ -0,0 +1,105 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/skip2/go-qrcode"
+	"golang.org/x/text/unicode/norm"
+)
+
+// SeedQRFromMnemonic returns the Standard SeedQR digit stream for a mnemonic.
+// https://github.com/SeedSigner/seedsigner/blob/dev/docs/seed_qr/README.md
+func SeedQRFromMnemonic(mnemonic string) (string, error) {
+	mnemonic = norm.NFKD.String(mnemonic)
+	valid, err := ValidateMnemonic(mnemonic)
+	if !valid {
+		return "", err
+	}
+
+	var builder strings.Builder
+	for _, word := range strings.Split(mnemonic, " ") {
+		builder.WriteString(fmt.Sprintf("%04d", reverseWordMap[word]))
+	}
+
+	return builder.String(), nil
+}
+
+// MnemonicFromSeedQR returns the mnemonic for a Standard SeedQR digit stream.
+func MnemonicFromSeedQR(digits string) (string, error) {
+	if len(digits)%4 != 0 {
+		return "", fmt.Errorf("SeedQR must be a multiple of 4 digits (found %d)", len(digits))
+	}
+
+	words := make([]string, len(digits)/4)
+	for i := range words {
+		index, err := strconv.ParseUint(digits[i*4:i*4+4], 10, 16)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to parse SeedQR")
+		}
+		if index >= uint64(len(englishWordList)) {
+			return "", fmt.Errorf("invalid SeedQR word index %d at position %d", index, i+1)
+		}
+		words[i] = englishWordList[index]
+	}
+
+	mnemonic := strings.Join(words, " ")
+	valid, err := ValidateMnemonic(mnemonic)
+	if !valid {
+		return "", err
+	}
+
+	return mnemonic, nil
+}
+
+// CompactSeedQRFromMnemonic returns the Compact SeedQR payload for a mnemonic,
+// which is the entropy of the mnemonic without its checksum.
+func CompactSeedQRFromMnemonic(mnemonic string) ([]byte, error) {
+	return entropyFromMnemonic(mnemonic)
+}
+
+// MnemonicFromCompactSeedQR returns the mnemonic for a Compact SeedQR payload.
+func MnemonicFromCompactSeedQR(data []byte) (string, error) {
+	if len(data) != 32 {
+		return "", fmt.Errorf("compact SeedQR must be 32 bytes (found %d)", len(data))
+	}
+
+	return mnemonicFromEntropy(data)
+}
+
+// SeedQRPNG renders a SeedQR payload, either the Standard SeedQR digits or the
+// Compact SeedQR bytes, as a PNG image of the given width and height in pixels.
+func SeedQRPNG(payload []byte, size int) ([]byte, error) {
+	code, err := qrcode.New(string(payload), qrcode.Low)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate QR code")
+	}
+
+	return code.PNG(size)
+}
+
+// SeedQRTerminal renders a SeedQR payload, either the Standard SeedQR digits or
+// the Compact SeedQR bytes, as block characters for display in a terminal.
+func SeedQRTerminal(payload []byte) (string, error) {
+	code, err := qrcode.New(string(payload), qrcode.Low)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate QR code")
+	}
+
+	return code.ToSmallString(false), nil
+}
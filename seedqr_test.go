@@ -0,0 +1,127 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeedQR(t *testing.T) {
+	type test struct {
+		name     string
+		mnemonic string
+		err      string
+		digits   string
+	}
+
+	tests := []test{
+		{
+			name:     "Invalid",
+			mnemonic: "attack pizza motion",
+			err:      "mnemonic must be 24 words (found 3)",
+		},
+		{
+			name:     "SeedSigner",
+			mnemonic: "attack pizza motion avocado network gather crop fresh patrol unusual wild holiday candy pony ranch winter theme error hybrid van cereal salon goddess expire",
+			digits:   "011513251154012711900771041507421289190620080870026613431420201617920614089619290300152408010643",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			digits, err := SeedQRFromMnemonic(test.mnemonic)
+			if test.err == "" {
+				require.NoError(t, err)
+				require.Equal(t, test.digits, digits)
+				mnemonic, err := MnemonicFromSeedQR(digits)
+				require.NoError(t, err)
+				require.Equal(t, test.mnemonic, mnemonic)
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestMnemonicFromSeedQR(t *testing.T) {
+	type test struct {
+		name   string
+		digits string
+		err    string
+	}
+
+	tests := []test{
+		{
+			name:   "Short",
+			digits: "01151",
+			err:    "SeedQR must be a multiple of 4 digits (found 5)",
+		},
+		{
+			name:   "NotDigits",
+			digits: "0115abcd",
+			err:    `failed to parse SeedQR: strconv.ParseUint: parsing "abcd": invalid syntax`,
+		},
+		{
+			name:   "BadIndex",
+			digits: "01152048",
+			err:    "invalid SeedQR word index 2048 at position 2",
+		},
+		{
+			name:   "BadChecksum",
+			digits: "011513251154012711900771041507421289190620080870026613431420201617920614089619290300152408010644",
+			err:    "invalid mnemonic checksum",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := MnemonicFromSeedQR(test.digits)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestCompactSeedQR(t *testing.T) {
+	mnemonic := "attack pizza motion avocado network gather crop fresh patrol unusual wild holiday candy pony ranch winter theme error hybrid van cereal salon goddess expire"
+
+	data, err := CompactSeedQRFromMnemonic(mnemonic)
+	require.NoError(t, err)
+	require.Len(t, data, 32)
+
+	res, err := MnemonicFromCompactSeedQR(data)
+	require.NoError(t, err)
+	require.Equal(t, mnemonic, res)
+
+	_, err = MnemonicFromCompactSeedQR(data[:16])
+	require.EqualError(t, err, "compact SeedQR must be 32 bytes (found 16)")
+}
+
+func TestSeedQRRender(t *testing.T) {
+	digits, err := SeedQRFromMnemonic("attack pizza motion avocado network gather crop fresh patrol unusual wild holiday candy pony ranch winter theme error hybrid van cereal salon goddess expire")
+	require.NoError(t, err)
+
+	data, err := SeedQRPNG([]byte(digits), 256)
+	require.NoError(t, err)
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, 256, img.Bounds().Dx())
+
+	block, err := SeedQRTerminal([]byte(digits))
+	require.NoError(t, err)
+	require.NotEmpty(t, block)
+}
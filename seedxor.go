@@ -0,0 +1,88 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// SplitSeedXOR splits a mnemonic into the given number of parts using SeedXOR.
+// Each part is itself a valid mnemonic, and the XOR of the entropy of all parts
+// is the entropy of the original mnemonic.
+// https://seedxor.com/
+func SplitSeedXOR(mnemonic string, parts int) ([]string, error) {
+	if parts < 2 {
+		return nil, fmt.Errorf("must split into at least 2 parts (requested %d)", parts)
+	}
+
+	entropy, err := entropyFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	// All parts bar the last are random; the last is the XOR of the
+	// original entropy and the random parts.
+	res := make([]string, parts)
+	last := append([]byte{}, entropy...)
+	for i := 0; i < parts-1; i++ {
+		partEntropy := make([]byte, len(entropy))
+		if _, err := rand.Read(partEntropy); err != nil {
+			return nil, errors.Wrap(err, "failed to generate random entropy")
+		}
+		xorBytes(last, partEntropy)
+		res[i], err = mnemonicFromEntropy(partEntropy)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res[parts-1], err = mnemonicFromEntropy(last)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CombineSeedXOR combines mnemonics generated by SplitSeedXOR to recover the
+// original mnemonic.
+func CombineSeedXOR(parts ...string) (string, error) {
+	if len(parts) < 2 {
+		return "", fmt.Errorf("must combine at least 2 parts (passed %d)", len(parts))
+	}
+
+	var entropy []byte
+	for i, part := range parts {
+		partEntropy, err := entropyFromMnemonic(part)
+		if err != nil {
+			return "", errors.Wrap(err, fmt.Sprintf("invalid part %d", i+1))
+		}
+		if entropy == nil {
+			entropy = partEntropy
+			continue
+		}
+		xorBytes(entropy, partEntropy)
+	}
+
+	return mnemonicFromEntropy(entropy)
+}
+
+// xorBytes sets dst to dst XOR src.
+func xorBytes(dst []byte, src []byte) {
+	for i := range dst {
+		dst[i] ^= src[i]
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCombineSeedXOR(t *testing.T) {
+	type test struct {
+		name     string
+		parts    []string
+		err      string
+		mnemonic string
+	}
+
+	tests := []test{
+		{
+			name: "Empty",
+			err:  "must combine at least 2 parts (passed 0)",
+		},
+		{
+			name:  "Single",
+			parts: []string{"silent toe meat possible chair blossom wait occur this worth option bag nurse find fish scene bench asthma bike wage world quit primary indoor"},
+			err:   "must combine at least 2 parts (passed 1)",
+		},
+		{
+			name: "InvalidPart",
+			parts: []string{
+				"romance wink lottery autumn shop bring dawn tongue range crater truth ability miss spice fitness easy legal release recall obey exchange recycle dragon room",
+				"lion misery divide hurry latin fluid camp advance illegal lab pyramid unaware eager fringe sick camera series noodle toy crowd jeans select depth depth",
+			},
+			err: "invalid part 2: invalid mnemonic checksum",
+		},
+		{
+			name: "Coldcard",
+			parts: []string{
+				"romance wink lottery autumn shop bring dawn tongue range crater truth ability miss spice fitness easy legal release recall obey exchange recycle dragon room",
+				"lion misery divide hurry latin fluid camp advance illegal lab pyramid unaware eager fringe sick camera series noodle toy crowd jeans select depth lounge",
+				"vault nominee cradle silk own frown throw leg cactus recall talent worry gadget surface shy planet purpose coffee drip few seven term squeeze educate",
+			},
+			mnemonic: "silent toe meat possible chair blossom wait occur this worth option bag nurse find fish scene bench asthma bike wage world quit primary indoor",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mnemonic, err := CombineSeedXOR(test.parts...)
+			if test.err == "" {
+				require.NoError(t, err)
+				require.Equal(t, test.mnemonic, mnemonic)
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestSplitSeedXOR(t *testing.T) {
+	type test struct {
+		name     string
+		mnemonic string
+		parts    int
+		err      string
+	}
+
+	tests := []test{
+		{
+			name:     "TooFewParts",
+			mnemonic: "silent toe meat possible chair blossom wait occur this worth option bag nurse find fish scene bench asthma bike wage world quit primary indoor",
+			parts:    1,
+			err:      "must split into at least 2 parts (requested 1)",
+		},
+		{
+			name:     "InvalidMnemonic",
+			mnemonic: "silent toe meat",
+			parts:    2,
+			err:      "mnemonic must be 24 words (found 3)",
+		},
+		{
+			name:     "Two",
+			mnemonic: "silent toe meat possible chair blossom wait occur this worth option bag nurse find fish scene bench asthma bike wage world quit primary indoor",
+			parts:    2,
+		},
+		{
+			name:     "Four",
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+			parts:    4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parts, err := SplitSeedXOR(test.mnemonic, test.parts)
+			if test.err == "" {
+				require.NoError(t, err)
+				require.Len(t, parts, test.parts)
+				for _, part := range parts {
+					valid, err := ValidateMnemonic(part)
+					require.NoError(t, err)
+					require.True(t, valid)
+				}
+				mnemonic, err := CombineSeedXOR(parts...)
+				require.NoError(t, err)
+				require.Equal(t, test.mnemonic, mnemonic)
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+// symmetricKeySalt is the HMAC key used to generate a SLIP-0021 master node.
+const symmetricKeySalt = "Symmetric key seed"
+
+// SymmetricKey is a node in a SLIP-0021 hierarchy of symmetric keys.
+// https://github.com/satoshilabs/slips/blob/master/slip-0021.md
+type SymmetricKey struct {
+	node []byte
+}
+
+// SymmetricMasterKeyFromSeed generates a SLIP-0021 master node given a seed.
+// The seed must be between 16 and 64 bytes to be valid.
+func SymmetricMasterKeyFromSeed(seed []byte) (*SymmetricKey, error) {
+	if err := checkSeedLen(seed); err != nil {
+		return nil, err
+	}
+
+	node, err := hmacSHA512([]byte(symmetricKeySalt), seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SymmetricKey{
+		node: node,
+	}, nil
+}
+
+// Child derives the child node with the given label.
+func (k *SymmetricKey) Child(label string) (*SymmetricKey, error) {
+	node, err := hmacSHA512(k.node[0:32], append([]byte{0x00}, label...))
+	if err != nil {
+		return nil, err
+	}
+
+	return &SymmetricKey{
+		node: node,
+	}, nil
+}
+
+// Key returns a copy of the 32-byte symmetric key of the node.
+func (k *SymmetricKey) Key() []byte {
+	return append([]byte{}, k.node[32:64]...)
+}
+
+// DeriveSymmetricKey derives a 32-byte symmetric key given a seed and a path
+// of labels, for example "SLIP-0021", "Master encryption key".
+func DeriveSymmetricKey(seed []byte, labels ...string) ([]byte, error) {
+	key, err := SymmetricMasterKeyFromSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+	for _, label := range labels {
+		key, err = key.Child(label)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key.Key(), nil
+}
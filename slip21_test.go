@@ -0,0 +1,76 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveSymmetricKey(t *testing.T) {
+	type test struct {
+		name   string
+		seed   []byte
+		labels []string
+		err    string
+		key    []byte
+	}
+
+	// Seed of the mnemonic "all all all all all all all all all all all all".
+	seed := _strToHex("c76c4ac4f4e4a00d6b274d5c39c700bb4a7ddc04fbc6f78e85ca75007b5b495f74a9043eeb77bdd53aa6fc3a0e31462270316fa04b8c19114c8798706cd02ac8")
+
+	tests := []test{
+		{
+			name: "SeedShort",
+			seed: _strToHex("0000"),
+			err:  "seed must be between 16 and 64 bytes (passed 2)",
+		},
+		{
+			name: "Master",
+			seed: seed,
+			key:  _strToHex("dbf12b44133eaab506a740f6565cc117228cbf1dd70635cfa8ddfdc9af734756"),
+		},
+		{
+			name:   "Child",
+			seed:   seed,
+			labels: []string{"SLIP-0021"},
+			key:    _strToHex("1d065e3ac1bbe5c7fad32cf2305f7d709dc070d672044a19e610c77cdf33de0d"),
+		},
+		{
+			name:   "EncryptionKey",
+			seed:   seed,
+			labels: []string{"SLIP-0021", "Master encryption key"},
+			key:    _strToHex("ea163130e35bbafdf5ddee97a17b39cef2be4b4f390180d65b54cf05c6a82fde"),
+		},
+		{
+			name:   "AuthenticationKey",
+			seed:   seed,
+			labels: []string{"SLIP-0021", "Authentication key"},
+			key:    _strToHex("47194e938ab24cc82bfa25f6486ed54bebe79c40ae2a5a32ea6db294d81861a6"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := DeriveSymmetricKey(test.seed, test.labels...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.key, key)
+		})
+	}
+}
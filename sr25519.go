@@ -0,0 +1,165 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"fmt"
+
+	schnorrkel "github.com/ChainSafe/go-schnorrkel"
+	"github.com/pkg/errors"
+)
+
+// sr25519SigningContext is the signing context used by Substrate.
+var sr25519SigningContext = []byte("substrate")
+
+// SR25519Key is a Schnorrkel key on the Ristretto group, as used by Substrate.
+type SR25519Key struct {
+	secret *schnorrkel.SecretKey
+}
+
+// DeriveSR25519Key derives an sr25519 key given a Substrate secret URI, for
+// example //Alice or //Alice/soft.  Both hard and soft junctions are supported.
+func DeriveSR25519Key(suri string) (*SR25519Key, error) {
+	uri, err := ParseSubstrateURI(suri)
+	if err != nil {
+		return nil, err
+	}
+	seed, err := uri.MiniSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	var miniSecretBytes [schnorrkel.MiniSecretKeySize]byte
+	copy(miniSecretBytes[:], seed)
+	miniSecret, err := schnorrkel.NewMiniSecretKeyFromRaw(miniSecretBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create mini-secret")
+	}
+
+	key := &SR25519Key{
+		secret: miniSecret.ExpandEd25519(),
+	}
+	for _, junction := range uri.Junctions {
+		var derived *schnorrkel.ExtendedKey
+		if junction.Hard {
+			derived, err = schnorrkel.DeriveKeyHard(key.secret, []byte{}, junction.ChainCode)
+		} else {
+			derived, err = schnorrkel.DeriveKeySoft(key.secret, []byte{}, junction.ChainCode)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to derive key")
+		}
+		secret, err := derived.Secret()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain secret key")
+		}
+		key = &SR25519Key{
+			secret: secret,
+		}
+	}
+
+	return key, nil
+}
+
+// PublicKey returns the 32-byte public key.
+func (k *SR25519Key) PublicKey() ([]byte, error) {
+	pub, err := k.secret.Public()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain public key")
+	}
+	res := pub.Encode()
+
+	return res[:], nil
+}
+
+// Sign signs a message in the Substrate signing context.
+func (k *SR25519Key) Sign(msg []byte) ([]byte, error) {
+	sig, err := k.secret.Sign(schnorrkel.NewSigningContext(sr25519SigningContext, msg))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign message")
+	}
+	res := sig.Encode()
+
+	return res[:], nil
+}
+
+// VerifySR25519 verifies a signature over a message in the Substrate signing context.
+func VerifySR25519(pubKey []byte, msg []byte, sig []byte) error {
+	pub, err := sr25519PublicKey(pubKey)
+	if err != nil {
+		return err
+	}
+	if len(sig) != schnorrkel.SignatureSize {
+		return fmt.Errorf("signature must be %d bytes (passed %d)", schnorrkel.SignatureSize, len(sig))
+	}
+	var sigBytes [schnorrkel.SignatureSize]byte
+	copy(sigBytes[:], sig)
+	signature := &schnorrkel.Signature{}
+	if err := signature.Decode(sigBytes); err != nil {
+		return errors.Wrap(ErrInvalidSignature, err.Error())
+	}
+
+	verified, err := pub.Verify(signature, schnorrkel.NewSigningContext(sr25519SigningContext, msg))
+	if err != nil {
+		return errors.Wrap(ErrInvalidSignature, err.Error())
+	}
+	if !verified {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// DeriveSR25519PublicKey derives a child public key from a parent public key
+// and a path of soft junctions, for example /soft/0.
+func DeriveSR25519PublicKey(pubKey []byte, path string) ([]byte, error) {
+	pub, err := sr25519PublicKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	// Only the junctions of the parsed path are used.
+	uri, err := ParseSubstrateURI(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var key schnorrkel.DerivableKey = pub
+	for _, junction := range uri.Junctions {
+		if junction.Hard {
+			return nil, ErrHardenedPublicDerivation
+		}
+		derived, err := schnorrkel.DeriveKeySoft(key, []byte{}, junction.ChainCode)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to derive key")
+		}
+		key = derived.Key()
+	}
+	res := key.Encode()
+
+	return res[:], nil
+}
+
+func sr25519PublicKey(pubKey []byte) (*schnorrkel.PublicKey, error) {
+	if len(pubKey) != schnorrkel.PublicKeySize {
+		return nil, fmt.Errorf("public key must be %d bytes (passed %d)", schnorrkel.PublicKeySize, len(pubKey))
+	}
+	var pubKeyBytes [schnorrkel.PublicKeySize]byte
+	copy(pubKeyBytes[:], pubKey)
+	pub, err := schnorrkel.NewPublicKey(pubKeyBytes)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidPublicKey, err.Error())
+	}
+
+	return pub, nil
+}
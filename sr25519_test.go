@@ -0,0 +1,157 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveSR25519Key(t *testing.T) {
+	type test struct {
+		name   string
+		suri   string
+		err    string
+		pubKey []byte
+	}
+
+	tests := []test{
+		{
+			name: "InvalidMnemonic",
+			suri: "bottom drive obey//Alice",
+			err:  "mnemonic must be 12, 15, 18, 21 or 24 words (found 3)",
+		},
+		{
+			name:   "DevPhrase",
+			suri:   "",
+			pubKey: _strToHex("46ebddef8cd9bb167dc30878d7113b7e168e6f0646beffd77d69d39bad76b47a"),
+		},
+		{
+			name:   "Alice",
+			suri:   "//Alice",
+			pubKey: _strToHex("d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"),
+		},
+		// subkey vectors.
+		{
+			name:   "Phrase",
+			suri:   "crowd swamp sniff machine grid pretty client emotion banana cricket flush soap",
+			pubKey: _strToHex("88af895626c47cf1235ec3898d238baeb41adca3117b9a77bc2f6b78eca0771b"),
+		},
+		{
+			name:   "Password",
+			suri:   "crowd swamp sniff machine grid pretty client emotion banana cricket flush soap///password",
+			pubKey: _strToHex("5c2d57c4cfa7df7a9d0e9546bb575045f5ec14e9771de8bc907910c84cd5de2a"),
+		},
+		{
+			name:   "Soft",
+			suri:   "crowd swamp sniff machine grid pretty client emotion banana cricket flush soap/foo",
+			pubKey: _strToHex("287061f5973551d070ccc62fb4563a0be2e6324ce183c456850e342aa021f94d"),
+		},
+		{
+			name:   "Hard",
+			suri:   "crowd swamp sniff machine grid pretty client emotion banana cricket flush soap//foo//42",
+			pubKey: _strToHex("de4255b281cda3580a7aad6d2c7efd990e6b31569ab1a0a8adc18b32e4fa510f"),
+		},
+		{
+			name:   "HardSoft",
+			suri:   "crowd swamp sniff machine grid pretty client emotion banana cricket flush soap//foo/bar",
+			pubKey: _strToHex("0c6febc87c461f8ddceb295d90c3ba999b1e93c2bdd13145b265512d06729449"),
+		},
+		{
+			name:   "SoftHard",
+			suri:   "crowd swamp sniff machine grid pretty client emotion banana cricket flush soap/foo//bar",
+			pubKey: _strToHex("e4535b3b8e259badc3c78128bfafe0b50df625862edaff7c9d68999a0811865b"),
+		},
+		{
+			name:   "Mixed",
+			suri:   "crowd swamp sniff machine grid pretty client emotion banana cricket flush soap//foo/bar//42/69",
+			pubKey: _strToHex("68a5a8f7e29ffcae1d15518b180f6e4f1132b45ffd565cb7953045faf07c8809"),
+		},
+		{
+			name:   "MixedPassword",
+			suri:   "crowd swamp sniff machine grid pretty client emotion banana cricket flush soap//foo/bar//42/69///password",
+			pubKey: _strToHex("4055514cd4ddcc7b23024839b68190f3f71bc262eb038145262bfe087bbb5429"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := DeriveSR25519Key(test.suri)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			pubKey, err := key.PublicKey()
+			require.NoError(t, err)
+			require.Equal(t, test.pubKey, pubKey)
+		})
+	}
+}
+
+func TestDeriveSR25519PublicKey(t *testing.T) {
+	parent, err := DeriveSR25519Key("//Alice")
+	require.NoError(t, err)
+	parentPubKey, err := parent.PublicKey()
+	require.NoError(t, err)
+
+	child, err := DeriveSR25519Key("//Alice/soft/0")
+	require.NoError(t, err)
+	childPubKey, err := child.PublicKey()
+	require.NoError(t, err)
+	require.NotEqual(t, parentPubKey, childPubKey)
+
+	// Soft derivation from the public key matches derivation from the secret key.
+	pubKey, err := DeriveSR25519PublicKey(parentPubKey, "/soft/0")
+	require.NoError(t, err)
+	require.Equal(t, childPubKey, pubKey)
+
+	// subkey inspect "crowd swamp sniff machine grid pretty client emotion banana cricket flush soap//foo/bar".
+	hard, err := DeriveSR25519Key("crowd swamp sniff machine grid pretty client emotion banana cricket flush soap//foo")
+	require.NoError(t, err)
+	hardPubKey, err := hard.PublicKey()
+	require.NoError(t, err)
+	pubKey, err = DeriveSR25519PublicKey(hardPubKey, "/bar")
+	require.NoError(t, err)
+	require.Equal(t, _strToHex("0c6febc87c461f8ddceb295d90c3ba999b1e93c2bdd13145b265512d06729449"), pubKey)
+
+	_, err = DeriveSR25519PublicKey(parentPubKey, "//hard")
+	require.EqualError(t, err, "cannot derive hardened child from public key")
+
+	_, err = DeriveSR25519PublicKey(parentPubKey[1:], "/soft")
+	require.EqualError(t, err, "public key must be 32 bytes (passed 31)")
+}
+
+func TestSR25519Sign(t *testing.T) {
+	key, err := DeriveSR25519Key("//Alice")
+	require.NoError(t, err)
+	pubKey, err := key.PublicKey()
+	require.NoError(t, err)
+
+	msg := []byte("test message")
+	sig, err := key.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, 64)
+
+	require.NoError(t, VerifySR25519(pubKey, msg, sig))
+	require.EqualError(t, VerifySR25519(pubKey, []byte("other message"), sig), "invalid signature")
+	require.EqualError(t, VerifySR25519(pubKey, msg, sig[1:]), "signature must be 64 bytes (passed 63)")
+
+	bob, err := DeriveSR25519Key("//Bob")
+	require.NoError(t, err)
+	bobPubKey, err := bob.PublicKey()
+	require.NoError(t, err)
+	require.EqualError(t, VerifySR25519(bobPubKey, msg, sig), "invalid signature")
+}
@@ -0,0 +1,239 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+// SubstrateDevPhrase is the well-known development mnemonic used by Substrate
+// when a secret URI has no phrase, for example //Alice.
+const SubstrateDevPhrase = "bottom drive obey lake curtain smoke basket hold race lonely fit walk"
+
+var (
+	// ErrInvalidSecretURI is returned when a Substrate secret URI cannot be parsed.
+	ErrInvalidSecretURI = errors.New("invalid secret URI")
+	// ErrSoftJunction is returned when a soft junction is used with ed25519.
+	ErrSoftJunction = errors.New("ed25519 does not support soft junctions")
+)
+
+// SubstrateJunction is a single step of a Substrate derivation path.
+type SubstrateJunction struct {
+	// Hard is true for hard (//) junctions and false for soft (/) junctions.
+	Hard bool
+	// ChainCode is the 32-byte chain code of the junction.
+	ChainCode [32]byte
+}
+
+// SubstrateURI is a parsed Substrate secret URI of the form
+// <phrase>//hard/soft///password.
+// https://docs.substrate.io/reference/command-line-tools/subkey/
+type SubstrateURI struct {
+	// Phrase is the mnemonic, or a 0x-prefixed hex mini-secret.
+	Phrase string
+	// Junctions are the derivation steps.
+	Junctions []SubstrateJunction
+	// Password is the BIP-39 passphrase.
+	Password string
+}
+
+// ParseSubstrateURI parses a Substrate secret URI.  If the URI has no phrase
+// the development phrase is used.
+func ParseSubstrateURI(suri string) (*SubstrateURI, error) {
+	res := &SubstrateURI{}
+	if pos := strings.Index(suri, "///"); pos != -1 {
+		res.Password = suri[pos+3:]
+		suri = suri[:pos]
+	}
+
+	pos := strings.Index(suri, "/")
+	if pos == -1 {
+		pos = len(suri)
+	}
+	res.Phrase = strings.TrimSpace(suri[:pos])
+	if res.Phrase == "" {
+		res.Phrase = SubstrateDevPhrase
+	}
+
+	path := suri[pos:]
+	for path != "" {
+		hard := strings.HasPrefix(path, "//")
+		path = strings.TrimPrefix(strings.TrimPrefix(path, "/"), "/")
+		end := strings.Index(path, "/")
+		if end == -1 {
+			end = len(path)
+		}
+		if end == 0 {
+			return nil, errors.Wrap(ErrInvalidSecretURI, "empty junction")
+		}
+		res.Junctions = append(res.Junctions, SubstrateJunction{
+			Hard:      hard,
+			ChainCode: substrateChainCode(path[:end]),
+		})
+		path = path[end:]
+	}
+
+	return res, nil
+}
+
+// MiniSecret returns the 32-byte mini-secret for the URI.  Unlike
+// SeedFromMnemonic this is generated from the mnemonic entropy rather than
+// from the mnemonic itself.
+func (s *SubstrateURI) MiniSecret() ([]byte, error) {
+	if strings.HasPrefix(s.Phrase, "0x") {
+		secret, err := hex.DecodeString(s.Phrase[2:])
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse hex mini-secret")
+		}
+		if len(secret) != 32 {
+			return nil, fmt.Errorf("mini-secret must be 32 bytes (passed %d)", len(secret))
+		}
+
+		return secret, nil
+	}
+
+	return SubstrateMiniSecret(s.Phrase, s.Password)
+}
+
+// SubstrateMiniSecret generates a Substrate mini-secret from a mnemonic of
+// 12 to 24 words and a password.
+func SubstrateMiniSecret(mnemonic string, password string) ([]byte, error) {
+	mnemonic = norm.NFKD.String(mnemonic)
+	password = norm.NFKD.String(password)
+	entropy, err := entropyFromWords(strings.Split(mnemonic, " "))
+	if err != nil {
+		return nil, err
+	}
+
+	seed := pbkdf2.Key(entropy, []byte("mnemonic"+password), 2048, 64, sha512.New)
+
+	return seed[:32], nil
+}
+
+// SubstrateKey is an ed25519 key derived with Substrate junctions.  Unlike
+// Key it has no chain code, so cannot be used for SLIP-0010 derivation.
+type SubstrateKey struct {
+	seed []byte
+}
+
+// DeriveSubstrateKey derives an ed25519 key given a Substrate secret URI,
+// for example //Alice//stash.
+func DeriveSubstrateKey(suri string) (*SubstrateKey, error) {
+	uri, err := ParseSubstrateURI(suri)
+	if err != nil {
+		return nil, err
+	}
+	seed, err := uri.MiniSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, junction := range uri.Junctions {
+		if !junction.Hard {
+			return nil, ErrSoftJunction
+		}
+		seed = substrateHardDerive(seed, junction.ChainCode)
+	}
+
+	return &SubstrateKey{
+		seed: seed,
+	}, nil
+}
+
+// Seed returns a copy of the 32-byte ed25519 seed of the key.
+func (k *SubstrateKey) Seed() [32]byte {
+	var seed [32]byte
+	copy(seed[:], k.seed)
+
+	return seed
+}
+
+// PublicKey returns the ed25519 public key.
+func (k *SubstrateKey) PublicKey() ([]byte, error) {
+	return Ed25519.publicKey(k.seed)
+}
+
+// substrateHardDerive derives a hard child ed25519 seed.
+func substrateHardDerive(seed []byte, chainCode [32]byte) []byte {
+	data := scaleBytes([]byte("Ed25519HDKD"))
+	data = append(data, seed...)
+	data = append(data, chainCode[:]...)
+	res := blake2b.Sum256(data)
+
+	return res[:]
+}
+
+// substrateChainCode returns the chain code for a junction.  Numeric
+// junctions are encoded as little-endian u64 and others as SCALE strings,
+// hashed if longer than 32 bytes.
+func substrateChainCode(junction string) [32]byte {
+	var data []byte
+	if number, err := strconv.ParseUint(junction, 10, 64); err == nil {
+		data = make([]byte, 8)
+		binary.LittleEndian.PutUint64(data, number)
+	} else {
+		data = scaleBytes([]byte(junction))
+	}
+
+	var res [32]byte
+	if len(data) > 32 {
+		res = blake2b.Sum256(data)
+	} else {
+		copy(res[:], data)
+	}
+
+	return res
+}
+
+// scaleBytes returns the SCALE encoding of a byte slice: a compact length
+// prefix followed by the bytes.
+func scaleBytes(data []byte) []byte {
+	return append(scaleCompact(uint64(len(data))), data...)
+}
+
+// scaleCompact returns the SCALE compact encoding of an integer.
+func scaleCompact(n uint64) []byte {
+	switch {
+	case n < 1<<6:
+		return []byte{byte(n << 2)}
+	case n < 1<<14:
+		res := make([]byte, 2)
+		binary.LittleEndian.PutUint16(res, uint16(n<<2|0x01))
+
+		return res
+	case n < 1<<30:
+		res := make([]byte, 4)
+		binary.LittleEndian.PutUint32(res, uint32(n<<2|0x02))
+
+		return res
+	default:
+		value := make([]byte, 8)
+		binary.LittleEndian.PutUint64(value, n)
+		for len(value) > 4 && value[len(value)-1] == 0 {
+			value = value[:len(value)-1]
+		}
+
+		return append([]byte{byte(len(value)-4)<<2 | 0x03}, value...)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSubstrateURI(t *testing.T) {
+	type test struct {
+		name     string
+		suri     string
+		err      string
+		phrase   string
+		hard     []bool
+		password string
+	}
+
+	tests := []test{
+		{
+			name:   "DevPhrase",
+			suri:   "",
+			phrase: SubstrateDevPhrase,
+		},
+		{
+			name:   "Junctions",
+			suri:   "//Alice/soft//0",
+			phrase: SubstrateDevPhrase,
+			hard:   []bool{true, false, true},
+		},
+		{
+			name:     "PhrasePassword",
+			suri:     "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about//foo///secret//pass",
+			phrase:   "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			hard:     []bool{true},
+			password: "secret//pass",
+		},
+		{
+			name:   "EmptyPassword",
+			suri:   "//Alice///",
+			phrase: SubstrateDevPhrase,
+			hard:   []bool{true},
+		},
+		{
+			name: "EmptyJunction",
+			suri: "//Alice/",
+			err:  "empty junction: invalid secret URI",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			uri, err := ParseSubstrateURI(test.suri)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			if test.phrase != "" {
+				require.Equal(t, test.phrase, uri.Phrase)
+			}
+			require.Len(t, uri.Junctions, len(test.hard))
+			for i := range test.hard {
+				require.Equal(t, test.hard[i], uri.Junctions[i].Hard)
+			}
+			require.Equal(t, test.password, uri.Password)
+		})
+	}
+}
+
+func TestSubstrateChainCode(t *testing.T) {
+	// Numeric junctions are little-endian u64.
+	chainCode := substrateChainCode("1")
+	require.Equal(t, _strToHex("0100000000000000000000000000000000000000000000000000000000000000"), chainCode[:])
+
+	// Text junctions are SCALE-encoded.
+	chainCode = substrateChainCode("Alice")
+	require.Equal(t, _strToHex("14416c6963650000000000000000000000000000000000000000000000000000"), chainCode[:])
+}
+
+func TestScaleCompact(t *testing.T) {
+	require.Equal(t, []byte{0x00}, scaleCompact(0))
+	require.Equal(t, []byte{0xfc}, scaleCompact(63))
+	require.Equal(t, []byte{0x01, 0x01}, scaleCompact(64))
+	require.Equal(t, []byte{0xfe, 0xff, 0x03, 0x00}, scaleCompact(65535))
+	require.Equal(t, []byte{0x03, 0x00, 0x00, 0x00, 0x40}, scaleCompact(1<<30))
+}
+
+func TestDeriveSubstrateKey(t *testing.T) {
+	type test struct {
+		name   string
+		suri   string
+		err    string
+		seed   []byte
+		pubKey []byte
+	}
+
+	tests := []test{
+		{
+			name: "Soft",
+			suri: "//Alice/soft",
+			err:  "ed25519 does not support soft junctions",
+		},
+		{
+			name: "BadMiniSecret",
+			suri: "0x1234//Alice",
+			err:  "mini-secret must be 32 bytes (passed 2)",
+		},
+		{
+			name:   "Alice",
+			suri:   "//Alice",
+			seed:   _strToHex("abf8e5bdbe30c65656c0a3cbd181ff8a56294a69dfedd27982aace4a76909115"),
+			pubKey: _strToHex("88dc3417d5058ec4b4503e0c12ea1a0a89be200fe98922423d4334014fa6b0ee"),
+		},
+		// subkey vectors.
+		{
+			name:   "Phrase",
+			suri:   "crowd swamp sniff machine grid pretty client emotion banana cricket flush soap",
+			seed:   _strToHex("18446f2d685492c3086391aabe8f5e235c3c2e02521985650f0c97052237e717"),
+			pubKey: _strToHex("e4631cda48cb885f3a6d0b521d3278ec3e834dd2e1766f7edb8e1386535cc217"),
+		},
+		{
+			name:   "HexSeed",
+			suri:   "0x18446f2d685492c3086391aabe8f5e235c3c2e02521985650f0c97052237e717",
+			seed:   _strToHex("18446f2d685492c3086391aabe8f5e235c3c2e02521985650f0c97052237e717"),
+			pubKey: _strToHex("e4631cda48cb885f3a6d0b521d3278ec3e834dd2e1766f7edb8e1386535cc217"),
+		},
+		{
+			name:   "Password",
+			suri:   "crowd swamp sniff machine grid pretty client emotion banana cricket flush soap///password",
+			seed:   _strToHex("d2dbfa26295528f3893430047b773e5bc5457b02c520c5d80bb83366d42de032"),
+			pubKey: _strToHex("261a29a2b6f690f394d339dc6e09f7f8fa85a3ed82b7567e2bb2a79c33651eef"),
+		},
+		{
+			name:   "HardJunctions",
+			suri:   "crowd swamp sniff machine grid pretty client emotion banana cricket flush soap//foo//42",
+			seed:   _strToHex("5a9060fb4a7441903228e7e7138a95ecc7f84ce4f153b37325a87b5f35829df1"),
+			pubKey: _strToHex("7a16bd534b1aab9d420d5ca544927ccff88f76e39b063faee502b63f7a2fb394"),
+		},
+		{
+			name:   "HardJunctionsPassword",
+			suri:   "crowd swamp sniff machine grid pretty client emotion banana cricket flush soap//foo//42///password",
+			seed:   _strToHex("21346646d89dfcf14d69152583ccd30f3ebc385f0b112c54b477be16ff4fcfb9"),
+			pubKey: _strToHex("34f7460f79c0c4947dfe1b4176ff8cf974883ed2f2a5c716ed89bd16b11e05dc"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := DeriveSubstrateKey(test.suri)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			seed := key.Seed()
+			require.Equal(t, test.seed, seed[:])
+			pubKey, err := key.PublicKey()
+			require.NoError(t, err)
+			require.Equal(t, test.pubKey, pubKey)
+		})
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var placeholderRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// PathTemplate is a derivation path in which elements can be ranges, such as
+// {0..999}, or named placeholders, such as {account}.
+type PathTemplate struct {
+	levels []*templateLevel
+}
+
+type templateLevel struct {
+	// name is the name of the placeholder, or empty for a fixed range.
+	name  string
+	start uint32
+	end   uint32
+}
+
+// ParsePathTemplate parses a path template such as m/44'/501'/{0..999}'/0'
+// or m/44'/1901'/{account}'/{index}.  The coin type can be given by name.
+//
+// As all elements are hardened when derived, a hardened marker is optional on
+// the fourth and fifth elements; paths are reported in the form accepted by
+// DeriveKey.
+func ParsePathTemplate(template string) (*PathTemplate, error) {
+	segments := strings.Split(template, "/")
+	if segments[0] != "m" || len(segments) < 2 {
+		return nil, errors.New("path template must start with m/")
+	}
+
+	levels := make([]*templateLevel, len(segments)-1)
+	for i, segment := range segments[1:] {
+		element := strings.TrimSuffix(segment, "'")
+		if i < 3 && element == segment {
+			return nil, fmt.Errorf("path template element %d must be hardened", i+1)
+		}
+		level, err := parseTemplateElement(element, i == 1)
+		if errors.Is(err, ErrInvalidPath) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("invalid path template element %d", i+1))
+		}
+		levels[i] = level
+	}
+
+	res := &PathTemplate{
+		levels: levels,
+	}
+	// Ensure that the template produces paths of a valid shape.
+	elements := make([]uint32, len(levels))
+	if !isValidPath(pathForElements(elements)) {
+		return nil, ErrInvalidPath
+	}
+
+	return res, nil
+}
+
+func parseTemplateElement(element string, coinType bool) (*templateLevel, error) {
+	if strings.HasPrefix(element, "{") && strings.HasSuffix(element, "}") {
+		inner := element[1 : len(element)-1]
+		if placeholderRegex.MatchString(inner) {
+			return &templateLevel{name: inner}, nil
+		}
+		start, end, err := parseTemplateRange(inner)
+		if err != nil {
+			return nil, err
+		}
+
+		return &templateLevel{start: start, end: end}, nil
+	}
+
+	if coinType && strings.Trim(element, "0123456789") != "" {
+		// As per resolveCoinType, an unknown coin name makes the path invalid.
+		index, err := CoinType(element)
+		if err != nil {
+			return nil, ErrInvalidPath
+		}
+
+		return &templateLevel{start: index, end: index}, nil
+	}
+
+	start, end, err := parseTemplateRange(element)
+	if err != nil {
+		return nil, err
+	}
+	if start != end {
+		return nil, errors.New("ranges must be enclosed in braces")
+	}
+
+	return &templateLevel{start: start, end: end}, nil
+}
+
+// parseTemplateRange parses either a single value or an inclusive range a..b.
+func parseTemplateRange(input string) (uint32, uint32, error) {
+	startStr, endStr, isRange := strings.Cut(input, "..")
+	if !isRange {
+		endStr = startStr
+	}
+
+	start, err := strconv.ParseUint(startStr, 10, 32)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to parse element")
+	}
+	end, err := strconv.ParseUint(endStr, 10, 32)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to parse element")
+	}
+	if start > end {
+		return 0, 0, fmt.Errorf("range start %d is after range end %d", start, end)
+	}
+	if end >= uint64(hardenedOffset) {
+		return 0, 0, ErrHardenedElementTooLarge
+	}
+
+	return uint32(start), uint32(end), nil
+}
+
+// Keys returns an iterator over the paths and keys of the template for the
+// given seed.  vars supplies the values of named placeholders, each of which
+// is either a single value such as "7" or an inclusive range such as "0..9".
+func (t *PathTemplate) Keys(seed []byte, vars map[string]string) (*PathIterator, error) {
+	levels := make([]*templateLevel, len(t.levels))
+	for i, level := range t.levels {
+		if level.name == "" {
+			levels[i] = level
+			continue
+		}
+		value, exists := vars[level.name]
+		if !exists {
+			return nil, fmt.Errorf("no value for placeholder {%s}", level.name)
+		}
+		start, end, err := parseTemplateRange(value)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("invalid value for placeholder {%s}", level.name))
+		}
+		levels[i] = &templateLevel{start: start, end: end}
+	}
+
+	master, err := MasterKeyFromSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PathIterator{
+		levels:   levels,
+		master:   master,
+		elements: make([]uint32, len(levels)),
+		keys:     make([]*Key, len(levels)),
+	}, nil
+}
+
+// PathIterator iterates over the paths and keys of a path template.  Keys
+// are derived lazily, and parent keys are shared between paths with a common
+// prefix.
+type PathIterator struct {
+	levels   []*templateLevel
+	master   *Key
+	elements []uint32
+	// keys caches the derived key at each level of the current path.
+	keys    []*Key
+	started bool
+	done    bool
+	err     error
+}
+
+// Next advances the iterator to the next path, returning false when there
+// are no more paths or an error occurred.
+func (i *PathIterator) Next() bool {
+	if i.done {
+		return false
+	}
+
+	// Find the first level that changes.
+	changed := 0
+	if !i.started {
+		i.started = true
+		for j, level := range i.levels {
+			i.elements[j] = level.start
+		}
+	} else {
+		changed = -1
+		for j := len(i.levels) - 1; j >= 0; j-- {
+			if i.elements[j] < i.levels[j].end {
+				i.elements[j]++
+				changed = j
+				break
+			}
+			i.elements[j] = i.levels[j].start
+		}
+		if changed == -1 {
+			i.done = true
+			return false
+		}
+	}
+
+	// Derive keys from the first changed level down.
+	for j := changed; j < len(i.levels); j++ {
+		parent := i.master
+		if j > 0 {
+			parent = i.keys[j-1]
+		}
+		key, err := deriveKeyElements(parent, i.elements[j:j+1])
+		if err != nil {
+			i.err = err
+			i.done = true
+			return false
+		}
+		i.keys[j] = key
+	}
+
+	return true
+}
+
+// Path returns the current path.
+func (i *PathIterator) Path() string {
+	return pathForElements(i.elements)
+}
+
+// Key returns the key for the current path.
+func (i *PathIterator) Key() *Key {
+	return i.keys[len(i.keys)-1]
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (i *PathIterator) Err() error {
+	return i.err
+}
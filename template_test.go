@@ -0,0 +1,167 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePathTemplate(t *testing.T) {
+	type test struct {
+		name     string
+		template string
+		err      string
+	}
+
+	tests := []test{
+		{
+			name:     "Empty",
+			template: "",
+			err:      "path template must start with m/",
+		},
+		{
+			name:     "NotHardened",
+			template: "m/44'/501/{0..9}'",
+			err:      "path template element 2 must be hardened",
+		},
+		{
+			name:     "BadRange",
+			template: "m/44'/501'/{9..0}'",
+			err:      "invalid path template element 3: range start 9 is after range end 0",
+		},
+		{
+			name:     "BadElement",
+			template: "m/44'/501'/{x..y}'",
+			err:      `invalid path template element 3: failed to parse element: strconv.ParseUint: parsing "x": invalid syntax`,
+		},
+		{
+			name:     "UnbracedRange",
+			template: "m/44'/501'/0..9'",
+			err:      "invalid path template element 3: ranges must be enclosed in braces",
+		},
+		{
+			name:     "UnknownCoin",
+			template: "m/44'/unknown'/0'",
+			err:      "invalid path",
+		},
+		{
+			name:     "TooLarge",
+			template: "m/44'/501'/{0..2147483648}'",
+			err:      "invalid path template element 3: hardened path element cannot be larger than 2147483647",
+		},
+		{
+			name:     "TooDeep",
+			template: "m/44'/501'/0'/0/0/{0..9}",
+			err:      "invalid path",
+		},
+		{
+			name:     "Range",
+			template: "m/44'/501'/{0..999}'/0'",
+		},
+		{
+			name:     "Named",
+			template: "m/44'/1901'/{account}'/{index}",
+		},
+		{
+			name:     "CoinName",
+			template: "m/44'/solana'/{0..9}'",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParsePathTemplate(test.template)
+			if test.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestPathTemplateKeys(t *testing.T) {
+	seed := _strToHex("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000")
+
+	type test struct {
+		name     string
+		template string
+		vars     map[string]string
+		err      string
+		paths    []string
+	}
+
+	tests := []test{
+		{
+			name:     "MissingVar",
+			template: "m/44'/1901'/{account}'",
+			err:      "no value for placeholder {account}",
+		},
+		{
+			name:     "BadVar",
+			template: "m/44'/1901'/{account}'",
+			vars:     map[string]string{"account": "a"},
+			err:      `invalid value for placeholder {account}: failed to parse element: strconv.ParseUint: parsing "a": invalid syntax`,
+		},
+		{
+			name:     "Fixed",
+			template: "m/44'/1901'/0'",
+			paths:    []string{"m/44'/1901'/0'"},
+		},
+		{
+			name:     "Range",
+			template: "m/44'/501'/{0..2}'/0'",
+			paths:    []string{"m/44'/501'/0'/0", "m/44'/501'/1'/0", "m/44'/501'/2'/0"},
+		},
+		{
+			name:     "Named",
+			template: "m/44'/1901'/{account}'/{change}/{index}",
+			vars:     map[string]string{"account": "0", "change": "0..1", "index": "0..1"},
+			paths:    []string{"m/44'/1901'/0'/0/0", "m/44'/1901'/0'/0/1", "m/44'/1901'/0'/1/0", "m/44'/1901'/0'/1/1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			template, err := ParsePathTemplate(test.template)
+			require.NoError(t, err)
+			iter, err := template.Keys(seed, test.vars)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+
+			paths := make([]string, 0)
+			for iter.Next() {
+				paths = append(paths, iter.Path())
+				expected, err := DeriveKey(seed, iter.Path())
+				require.NoError(t, err)
+				require.Equal(t, expected, iter.Key())
+			}
+			require.NoError(t, iter.Err())
+			require.Equal(t, test.paths, paths)
+			require.False(t, iter.Next())
+		})
+	}
+}
+
+func TestPathTemplateKeysInvalidSeed(t *testing.T) {
+	template, err := ParsePathTemplate("m/44'/1901'/{0..9}'")
+	require.NoError(t, err)
+	_, err = template.Keys(nil, nil)
+	require.EqualError(t, err, "seed must be between 16 and 64 bytes (passed 0)")
+}
@@ -0,0 +1,169 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+)
+
+// redacted replaces secret values in a trace that does not show secrets.
+const redacted = "<redacted>"
+
+// DerivationTrace records each step in the derivation of a key.
+type DerivationTrace struct {
+	// Path is the path of the derived key.
+	Path string `json:"path"`
+	// Steps are the derivation steps, starting with the master key.
+	Steps []*DerivationStep `json:"steps"`
+}
+
+// DerivationStep is a single step in a derivation trace.  Binary values are
+// hex encoded, and secret values are redacted unless requested.
+type DerivationStep struct {
+	// Path is the path of the key generated by this step.
+	Path string `json:"path"`
+	// Index is the child index, including the hardened offset; it is empty
+	// for the master key.
+	Index string `json:"index,omitempty"`
+	// HMACKey is the key of the HMAC-SHA512: the fixed salt for the master
+	// key or the parent chain code for a child.
+	HMACKey string `json:"hmac_key"`
+	// HMACData is the input to the HMAC-SHA512: the seed for the master key
+	// or 00 || parent key || index for a child.
+	HMACData string `json:"hmac_data"`
+	// Key is I_L, the left half of the HMAC output.
+	Key string `json:"key"`
+	// ChainCode is I_R, the right half of the HMAC output.
+	ChainCode string `json:"chain_code"`
+	// PublicKey is the public key for Key.
+	PublicKey string `json:"public_key"`
+}
+
+// DeriveKeyTrace derives a key given a seed and a derivation path, as per
+// DeriveKey, and returns a trace of every step in the derivation.  Seeds, keys
+// and chain codes in the trace are redacted unless showSecrets is true.
+func DeriveKeyTrace(seed []byte, path string, showSecrets bool) (*DerivationTrace, error) {
+	path, err := resolveCoinType(path)
+	if err != nil {
+		return nil, err
+	}
+	if !isValidPath(path) {
+		return nil, ErrInvalidPath
+	}
+	elements, err := elementsForPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := func(data []byte) string {
+		if !showSecrets {
+			return redacted
+		}
+		return hex.EncodeToString(data)
+	}
+
+	key, err := MasterKeyFromSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+	step, err := traceStep(key, "m", secret)
+	if err != nil {
+		return nil, err
+	}
+	step.HMACKey = masterKeySalt
+	step.HMACData = secret(seed)
+	trace := &DerivationTrace{
+		Path:  path,
+		Steps: []*DerivationStep{step},
+	}
+
+	for i, element := range elements {
+		index := element + hardenedOffset
+		data := childData(key, index)
+		parent := key
+		key, err = deriveKey(key, index)
+		if err != nil {
+			return nil, err
+		}
+		step, err := traceStep(key, pathForElements(elements[:i+1]), secret)
+		if err != nil {
+			return nil, err
+		}
+		step.Index = hex.EncodeToString(data[len(data)-4:])
+		step.HMACKey = secret(parent.chainCode)
+		step.HMACData = fmt.Sprintf("00 || %s || %s", secret(parent.key), step.Index)
+		trace.Steps = append(trace.Steps, step)
+	}
+
+	return trace, nil
+}
+
+func traceStep(key *Key, path string, secret func([]byte) string) (*DerivationStep, error) {
+	pubKey, err := key.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return &DerivationStep{
+		Path:      path,
+		Key:       secret(key.key),
+		ChainCode: secret(key.chainCode),
+		PublicKey: hex.EncodeToString(pubKey),
+	}, nil
+}
+
+// JSON returns the trace as indented JSON.
+func (t *DerivationTrace) JSON() (string, error) {
+	var builder strings.Builder
+	encoder := json.NewEncoder(&builder)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(t); err != nil {
+		return "", errors.Wrap(err, "failed to marshal trace")
+	}
+
+	return builder.String(), nil
+}
+
+// String returns the trace as a table.
+func (t *DerivationTrace) String() string {
+	table, err := t.table()
+	if err != nil {
+		return fmt.Sprintf("failed to build trace table: %v", err)
+	}
+
+	return table
+}
+
+// table returns the trace as a table.
+func (t *DerivationTrace) table() (string, error) {
+	var builder strings.Builder
+	writer := tabwriter.NewWriter(&builder, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "PATH\tINDEX\tHMAC KEY\tHMAC DATA\tKEY\tCHAIN CODE\tPUBLIC KEY")
+	for _, step := range t.Steps {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			step.Path, step.Index, step.HMACKey, step.HMACData, step.Key, step.ChainCode, step.PublicKey)
+	}
+	if err := writer.Flush(); err != nil {
+		return "", errors.Wrap(err, "failed to flush trace table")
+	}
+
+	return builder.String(), nil
+}
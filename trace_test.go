@@ -0,0 +1,86 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveKeyTrace(t *testing.T) {
+	seed := _strToHex("000102030405060708090a0b0c0d0e0f")
+
+	_, err := DeriveKeyTrace(seed, "m/44", true)
+	require.EqualError(t, err, "invalid path")
+	_, err = DeriveKeyTrace(nil, "m/0'", true)
+	require.EqualError(t, err, "seed must be between 16 and 64 bytes (passed 0)")
+
+	trace, err := DeriveKeyTrace(seed, "m/0'/1'", true)
+	require.NoError(t, err)
+	require.Equal(t, []*DerivationStep{
+		{
+			Path:      "m",
+			HMACKey:   "ed25519 seed",
+			HMACData:  "000102030405060708090a0b0c0d0e0f",
+			Key:       "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+			ChainCode: "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb",
+			PublicKey: "a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed",
+		},
+		{
+			Path:      "m/0'",
+			Index:     "80000000",
+			HMACKey:   "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb",
+			HMACData:  "00 || 2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7 || 80000000",
+			Key:       "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+			ChainCode: "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69",
+			PublicKey: "8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c",
+		},
+		{
+			Path:      "m/0'/1'",
+			Index:     "80000001",
+			HMACKey:   "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69",
+			HMACData:  "00 || 68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3 || 80000001",
+			Key:       "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2",
+			ChainCode: "a320425f77d1b5c2505a6b1b27382b37368ee640e3557c315416801243552f14",
+			PublicKey: "1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187",
+		},
+	}, trace.Steps)
+}
+
+func TestDeriveKeyTraceRedacted(t *testing.T) {
+	seed := _strToHex("000102030405060708090a0b0c0d0e0f")
+
+	trace, err := DeriveKeyTrace(seed, "m/0'", false)
+	require.NoError(t, err)
+	require.Len(t, trace.Steps, 2)
+	for _, step := range trace.Steps {
+		require.Equal(t, "<redacted>", step.Key)
+		require.Equal(t, "<redacted>", step.ChainCode)
+		require.NotEqual(t, "<redacted>", step.PublicKey)
+	}
+	require.Equal(t, "<redacted>", trace.Steps[0].HMACData)
+	require.Equal(t, "00 || <redacted> || 80000000", trace.Steps[1].HMACData)
+
+	json, err := trace.JSON()
+	require.NoError(t, err)
+	require.Contains(t, json, `"hmac_data": "00 || <redacted> || 80000000"`)
+	require.NotContains(t, json, "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7")
+
+	table := trace.String()
+	require.Len(t, strings.Split(strings.TrimSpace(table), "\n"), 3)
+	require.True(t, strings.HasPrefix(table, "PATH"))
+	require.NotContains(t, table, "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7")
+}
@@ -0,0 +1,61 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"crypto/sha512"
+	"fmt"
+
+	"filippo.io/edwards25519"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrX25519Curve is returned when X25519 conversion is requested for a key that is not on the Ed25519 curve.
+	ErrX25519Curve = errors.New("X25519 conversion requires an ed25519 key")
+	// ErrLowOrderPoint is returned when a public key is a point of small order.
+	ErrLowOrderPoint = errors.New("public key is a low-order point")
+)
+
+// X25519PrivateKey returns the X25519 private key that corresponds to the
+// Ed25519 key: the clamped first half of the SHA-512 hash of its seed.
+func (k *Key) X25519PrivateKey() ([]byte, error) {
+	if k.Curve() != Ed25519 {
+		return nil, ErrX25519Curve
+	}
+
+	hash := sha512.Sum512(k.key)
+	hash[0] &= 0xf8
+	hash[31] &= 0x7f
+	hash[31] |= 0x40
+
+	return hash[:32], nil
+}
+
+// X25519PublicKey converts an Ed25519 public key to the Montgomery form used
+// by X25519.  Low-order points are rejected.
+func X25519PublicKey(pubKey []byte) ([]byte, error) {
+	if len(pubKey) != 32 {
+		return nil, fmt.Errorf("public key must be 32 bytes (passed %d)", len(pubKey))
+	}
+	point, err := new(edwards25519.Point).SetBytes(pubKey)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidPublicKey, err.Error())
+	}
+	if new(edwards25519.Point).MultByCofactor(point).Equal(edwards25519.NewIdentityPoint()) == 1 {
+		return nil, ErrLowOrderPoint
+	}
+
+	return point.BytesMontgomery(), nil
+}
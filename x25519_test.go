@@ -0,0 +1,97 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519hd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+)
+
+func TestX25519PublicKey(t *testing.T) {
+	type test struct {
+		name   string
+		pubKey []byte
+		err    string
+	}
+
+	tests := []test{
+		{
+			name:   "Short",
+			pubKey: _strToHex("0000"),
+			err:    "public key must be 32 bytes (passed 2)",
+		},
+		{
+			name:   "Identity",
+			pubKey: _strToHex("0100000000000000000000000000000000000000000000000000000000000000"),
+			err:    "public key is a low-order point",
+		},
+		{
+			name:   "OrderTwo",
+			pubKey: _strToHex("ecffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f"),
+			err:    "public key is a low-order point",
+		},
+		{
+			name:   "OrderEight",
+			pubKey: _strToHex("c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a"),
+			err:    "public key is a low-order point",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := X25519PublicKey(test.pubKey)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestX25519Conversion(t *testing.T) {
+	seed := _strToHex("000102030405060708090a0b0c0d0e0f")
+
+	alice, err := DeriveKey(seed, "m/44'/501'/0'")
+	require.NoError(t, err)
+	bob, err := DeriveKey(seed, "m/44'/501'/1'")
+	require.NoError(t, err)
+
+	// The converted public key matches the converted private key.
+	alicePrivKey, err := alice.X25519PrivateKey()
+	require.NoError(t, err)
+	aliceEdPubKey, err := alice.PublicKey()
+	require.NoError(t, err)
+	alicePubKey, err := X25519PublicKey(aliceEdPubKey)
+	require.NoError(t, err)
+	expected, err := curve25519.X25519(alicePrivKey, curve25519.Basepoint)
+	require.NoError(t, err)
+	require.Equal(t, expected, alicePubKey)
+
+	// Converted keys agree on a shared secret.
+	bobPrivKey, err := bob.X25519PrivateKey()
+	require.NoError(t, err)
+	bobEdPubKey, err := bob.PublicKey()
+	require.NoError(t, err)
+	bobPubKey, err := X25519PublicKey(bobEdPubKey)
+	require.NoError(t, err)
+	aliceSecret, err := curve25519.X25519(alicePrivKey, bobPubKey)
+	require.NoError(t, err)
+	bobSecret, err := curve25519.X25519(bobPrivKey, alicePubKey)
+	require.NoError(t, err)
+	require.Equal(t, aliceSecret, bobSecret)
+
+	curveKey, err := DeriveECDHKey(seed, "ssh://alice@example.com", 0)
+	require.NoError(t, err)
+	_, err = curveKey.X25519PrivateKey()
+	require.EqualError(t, err, "X25519 conversion requires an ed25519 key")
+}